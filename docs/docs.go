@@ -15,66 +15,222 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/task": {
+        "/api/audit": {
             "get": {
-                "description": "Retrieve a task using its unique identifier",
+                "description": "Retrieve a page of the changes made to tasks, most recent first. Available to admins only",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get task by ID",
+                "summary": "Get the audit log",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the user who made the changes",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "register",
+                            "update",
+                            "delete",
+                            "complete",
+                            "restore",
+                            "skip",
+                            "undo",
+                            "rollover"
+                        ],
+                        "type": "string",
+                        "description": "Kind of the changes",
+                        "name": "operation",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Task ID",
-                        "name": "id",
+                        "name": "task_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day in YYYYMMDD format",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day in YYYYMMDD format",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries on the page, capped by the pagination limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entries skipped before the page",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/audit.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/audit.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin rights required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to read audit log",
+                        "schema": {
+                            "$ref": "#/definitions/audit.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/completions": {
+            "get": {
+                "description": "Retrieve the completions made between two dates inclusive, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get completions of all tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day in YYYYMMDD format",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day in YYYYMMDD format",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/completions.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid date",
+                        "schema": {
+                            "$ref": "#/definitions/completions.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to read completions",
+                        "schema": {
+                            "$ref": "#/definitions/completions.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/nextdate/preview": {
+            "get": {
+                "description": "List the upcoming dates produced by a repeat rule",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Preview repeat rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date in YYYYMMDD format",
+                        "name": "date",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Repeat rule",
+                        "name": "repeat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of dates to list (1-50, default 5)",
+                        "name": "count",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/readone.Response"
+                            "$ref": "#/definitions/next.PreviewResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid task ID",
+                        "description": "Invalid request parameters",
                         "schema": {
-                            "$ref": "#/definitions/readone.Response"
+                            "$ref": "#/definitions/next.PreviewResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Task not found",
+                    }
+                }
+            }
+        },
+        "/api/projects": {
+            "get": {
+                "description": "Retrieve all projects, active ones first, in alphabetical order",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get projects",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/readone.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_read.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to find task by ID",
+                        "description": "Failed to read projects",
                         "schema": {
-                            "$ref": "#/definitions/readone.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_read.Response"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Modify the details of an existing task by its ID",
+                "description": "Rename, recolour, archive or unarchive a project. Archived projects keep their tasks but take no new ones",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Update an existing task",
+                "summary": "Update a project",
                 "parameters": [
                     {
-                        "description": "Task data to update",
+                        "description": "Project data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/update.Request"
+                            "$ref": "#/definitions/internal_delivery_http_projects_update.Request"
                         }
                     }
                 ],
@@ -82,40 +238,46 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/update.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_update.Response"
                         }
                     },
                     "400": {
                         "description": "Invalid request format or missing fields",
                         "schema": {
-                            "$ref": "#/definitions/update.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_update.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_projects_update.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to update task",
+                        "description": "Failed to update project",
                         "schema": {
-                            "$ref": "#/definitions/update.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_update.Response"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a new task with the provided details",
+                "description": "Add a new project to keep a separate list of tasks",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Register a new task",
+                "summary": "Create a project",
                 "parameters": [
                     {
-                        "description": "Task data",
+                        "description": "Project data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/register.Request"
+                            "$ref": "#/definitions/internal_delivery_http_projects_create.Request"
                         }
                     }
                 ],
@@ -123,33 +285,33 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/register.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_create.Response"
                         }
                     },
                     "400": {
                         "description": "Invalid request format or missing fields",
                         "schema": {
-                            "$ref": "#/definitions/register.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_create.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to add task",
+                        "description": "Failed to create project",
                         "schema": {
-                            "$ref": "#/definitions/register.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_create.Response"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Permanently remove a task from the system",
+                "description": "Remove a project. Its tasks are kept without a project",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete task by its ID",
+                "summary": "Delete a project",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Task ID",
+                        "description": "Project ID",
                         "name": "id",
                         "in": "query",
                         "required": true
@@ -159,148 +321,2031 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/delete.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_delete.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid task ID",
+                        "description": "Invalid project ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_projects_delete.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
                         "schema": {
-                            "$ref": "#/definitions/delete.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_delete.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to delete task",
+                        "description": "Failed to delete project",
                         "schema": {
-                            "$ref": "#/definitions/delete.Response"
+                            "$ref": "#/definitions/internal_delivery_http_projects_delete.Response"
                         }
                     }
                 }
             }
         },
-        "/api/task/done": {
+        "/api/signin": {
             "post": {
-                "description": "Mark task as completed, either by deleting it or modifying its deadline",
+                "description": "Check the name and password of an account and issue a token to authenticate its requests with,\neither in the token cookie or as a bearer token. Sign-ins without a name are made with the owner account",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Complete task by its ID",
+                "summary": "Sign in",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Task ID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "Credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/singin.Request"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/complete.Response"
+                            "$ref": "#/definitions/singin.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid task ID",
+                        "description": "Invalid request format or missing fields",
                         "schema": {
-                            "$ref": "#/definitions/complete.Response"
+                            "$ref": "#/definitions/singin.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Wrong user name or password",
+                        "schema": {
+                            "$ref": "#/definitions/singin.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to complete task",
+                        "description": "Failed to sign in",
                         "schema": {
-                            "$ref": "#/definitions/complete.Response"
+                            "$ref": "#/definitions/singin.Response"
                         }
                     }
                 }
             }
         },
-        "/api/tasks": {
-            "get": {
-                "description": "Retrieve tasks optionally filtered by a search query",
+        "/api/signup": {
+            "post": {
+                "description": "Create an account to keep tasks in. Accounts can only be created once the admin has been set up from PASSWORD",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get tasks",
+                "summary": "Sign up",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search filter",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/signup.Request"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/read.Response"
+                            "$ref": "#/definitions/signup.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format or missing fields",
+                        "schema": {
+                            "$ref": "#/definitions/signup.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "User name is already taken or there is no admin account yet",
+                        "schema": {
+                            "$ref": "#/definitions/signup.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to read tasks",
+                        "description": "Failed to sign up",
                         "schema": {
-                            "$ref": "#/definitions/read.Response"
+                            "$ref": "#/definitions/signup.Response"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "complete.Response": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
+        },
+        "/api/tags": {
+            "get": {
+                "description": "Retrieve all tags in alphabetical order with the number of tasks carrying them",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tags.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to read tags",
+                        "schema": {
+                            "$ref": "#/definitions/tags.Response"
+                        }
+                    }
                 }
             }
         },
-        "delete.Response": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
+        "/api/tags/merge": {
+            "post": {
+                "description": "Move the tasks of the given tags to another tag, created if needed, and remove the merged tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Merge tags",
+                "parameters": [
+                    {
+                        "description": "Tags to merge and the tag to merge them into",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mergetags.Request"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mergetags.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format or missing fields",
+                        "schema": {
+                            "$ref": "#/definitions/mergetags.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/mergetags.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to merge tags",
+                        "schema": {
+                            "$ref": "#/definitions/mergetags.Response"
+                        }
+                    }
                 }
             }
         },
-        "models.Task": {
-            "type": "object",
-            "properties": {
+        "/api/tags/rename": {
+            "post": {
+                "description": "Give a tag a new name on all its tasks. Tags cannot be renamed to the name of another tag, merge them instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Rename a tag",
+                "parameters": [
+                    {
+                        "description": "Current and new tag name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/renametag.Request"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/renametag.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format or missing fields",
+                        "schema": {
+                            "$ref": "#/definitions/renametag.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/renametag.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "New name is taken by another tag",
+                        "schema": {
+                            "$ref": "#/definitions/renametag.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to rename tag",
+                        "schema": {
+                            "$ref": "#/definitions/renametag.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/task": {
+            "get": {
+                "description": "Retrieve a task using its unique identifier together with its subtasks and dependencies",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get task by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/readone.Response"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the task to be sent back in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "$ref": "#/definitions/readone.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/readone.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to find task by ID",
+                        "schema": {
+                            "$ref": "#/definitions/readone.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify the details of an existing task by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update an existing task",
+                "parameters": [
+                    {
+                        "description": "Task data to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_update.Request"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the task version the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_update.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format, missing fields, a repeat rule on a subtask or a malformed If-Match header",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_update.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task or project not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_update.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Project is archived",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_update.Response"
+                        }
+                    },
+                    "412": {
+                        "description": "Task was changed since the given version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_update.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update task",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_update.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new task with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Register a new task",
+                "parameters": [
+                    {
+                        "description": "Task data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/register.Request"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/register.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format, missing fields or a subtask which breaks the nesting rules",
+                        "schema": {
+                            "$ref": "#/definitions/register.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Project or parent task not found",
+                        "schema": {
+                            "$ref": "#/definitions/register.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Project is archived",
+                        "schema": {
+                            "$ref": "#/definitions/register.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to add task",
+                        "schema": {
+                            "$ref": "#/definitions/register.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permanently remove a task from the system",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete task by its ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the task version the deletion is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_delete.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID or If-Match header",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_delete.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_delete.Response"
+                        }
+                    },
+                    "412": {
+                        "description": "Task was changed since the given version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_delete.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete task",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_delete.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/task/dependency": {
+            "post": {
+                "description": "Block a task until the blocker is done. Dependencies which would make a task wait for itself are refused",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Make a task wait for another one",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the blocked task",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the blocking task",
+                        "name": "blocker_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/adddependency.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task or blocker ID",
+                        "schema": {
+                            "$ref": "#/definitions/adddependency.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/adddependency.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Dependency would create a cycle",
+                        "schema": {
+                            "$ref": "#/definitions/adddependency.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to add dependency",
+                        "schema": {
+                            "$ref": "#/definitions/adddependency.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove the dependency of a task on its blocker",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Stop a task from waiting for another one",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the blocked task",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the blocking task",
+                        "name": "blocker_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/removedependency.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task or blocker ID",
+                        "schema": {
+                            "$ref": "#/definitions/removedependency.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task does not depend on the blocker",
+                        "schema": {
+                            "$ref": "#/definitions/removedependency.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove dependency",
+                        "schema": {
+                            "$ref": "#/definitions/removedependency.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/task/done": {
+            "post": {
+                "description": "Mark task as completed, either by deleting it or modifying its deadline.\nTasks with open blockers are only completed when force is set",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Complete task by its ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Complete the task even if its blockers are still open",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the task version the completion is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/complete.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID, force flag, repeat rule or If-Match header",
+                        "schema": {
+                            "$ref": "#/definitions/complete.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/complete.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Task is blocked by open tasks",
+                        "schema": {
+                            "$ref": "#/definitions/complete.Response"
+                        }
+                    },
+                    "412": {
+                        "description": "Task was changed since the given version",
+                        "schema": {
+                            "$ref": "#/definitions/complete.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to complete task",
+                        "schema": {
+                            "$ref": "#/definitions/complete.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/task/history": {
+            "get": {
+                "description": "Retrieve every recorded completion of a task, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get completion history of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/history.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "$ref": "#/definitions/history.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/history.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to read task history",
+                        "schema": {
+                            "$ref": "#/definitions/history.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/task/move": {
+            "post": {
+                "description": "Put a task into a project, or take it out of its project when project_id is 0. The move can be undone",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Move a task to another project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Project ID, 0 for no project",
+                        "name": "project_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/move.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task or project ID",
+                        "schema": {
+                            "$ref": "#/definitions/move.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task or project not found",
+                        "schema": {
+                            "$ref": "#/definitions/move.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Project is archived",
+                        "schema": {
+                            "$ref": "#/definitions/move.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to move task",
+                        "schema": {
+                            "$ref": "#/definitions/move.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/task/skip": {
+            "post": {
+                "description": "Move a recurring task to its next occurrence without marking it as completed",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Skip the current occurrence of a recurring task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/skip.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID or task is not recurring",
+                        "schema": {
+                            "$ref": "#/definitions/skip.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/skip.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to skip task",
+                        "schema": {
+                            "$ref": "#/definitions/skip.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tasks": {
+            "get": {
+                "description": "Retrieve tasks optionally filtered by a search query, priority, project, status and tags",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search filter",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Priority from 1 (urgent) to 4 (low)",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "todo",
+                            "in_progress",
+                            "blocked",
+                            "done"
+                        ],
+                        "type": "string",
+                        "description": "Status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Tags, repeated or comma separated",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Whether tasks must have any or all of the tags, any by default",
+                        "name": "match",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "date",
+                            "priority",
+                            "status"
+                        ],
+                        "type": "string",
+                        "description": "Order of the tasks",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_read.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_read.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to read tasks",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_tasks_read.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tasks/batch": {
+            "post": {
+                "description": "Complete, delete, update or move tasks to another date in a single transaction.\nEither all operations are applied or, if any of them fails, none. The result of every operation is reported",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Apply operations to several tasks at once",
+                "parameters": [
+                    {
+                        "description": "Operations in the order they are applied",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/batch.Request"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format or operations",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Some operations failed and the batch was rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to apply batch",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/trash": {
+            "get": {
+                "description": "Retrieve deleted tasks which have not been purged yet, most recently deleted first",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get trashed tasks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/trash.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to read trash",
+                        "schema": {
+                            "$ref": "#/definitions/trash.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/trash/restore": {
+            "post": {
+                "description": "Take a deleted task out of the trash so that it is scheduled again",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Restore a task from the trash",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/restore.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "$ref": "#/definitions/restore.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Task is not in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/restore.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to restore task",
+                        "schema": {
+                            "$ref": "#/definitions/restore.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/undo": {
+            "post": {
+                "description": "Revert the most recent register, update, delete or complete operation made within the undo window",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Undo the last operation",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/undo.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Nothing to undo",
+                        "schema": {
+                            "$ref": "#/definitions/undo.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to undo operation",
+                        "schema": {
+                            "$ref": "#/definitions/undo.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users": {
+            "get": {
+                "description": "Retrieve all accounts in alphabetical order. Only admins may list the accounts",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_read.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin rights required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to read users",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_read.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Grant or withdraw admin rights and, if a password or a time zone is given, replace it for an account.\nOnly admins may update accounts, and the last admin cannot lose the rights",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_update.Request"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_update.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format or missing fields",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_update.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin rights required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_update.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "The last admin cannot lose admin rights",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_update.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_update.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add an account, with or without admin rights. Only admins may create accounts this way",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a user",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_create.Request"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_create.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format or missing fields",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_create.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin rights required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "User name is already taken",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_create.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_create.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an account together with its tasks, tags and projects. Only admins may delete accounts,\nand the last admin cannot be deleted",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_delete.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_delete.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin rights required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_delete.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "The last admin cannot be removed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_delete.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete user",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_http_users_delete.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "adddependency.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "audit.Response": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditEntry"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "batch.Operation": {
+            "type": "object",
+            "required": [
+                "action",
+                "id"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "complete",
+                        "delete",
+                        "update",
+                        "move_date"
+                    ]
+                },
+                "date": {
+                    "type": "string"
+                },
+                "force": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "task": {
+                    "$ref": "#/definitions/batch.Task"
+                },
+                "version": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "batch.Request": {
+            "type": "object",
+            "required": [
+                "operations"
+            ],
+            "properties": {
+                "operations": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/batch.Operation"
+                    }
+                }
+            }
+        },
+        "batch.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BatchResult"
+                    }
+                }
+            }
+        },
+        "batch.Task": {
+            "type": "object",
+            "required": [
+                "date",
+                "title"
+            ],
+            "properties": {
+                "anchor": {
+                    "type": "string",
+                    "enum": [
+                        "due",
+                        "completion"
+                    ]
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "exdates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "priority": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1
+                },
+                "project_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "reminders": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "repeat": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "todo",
+                        "in_progress",
+                        "blocked",
+                        "done"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "time": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "complete.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "completions.Response": {
+            "type": "object",
+            "properties": {
+                "completions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Completion"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "history.Response": {
+            "type": "object",
+            "properties": {
+                "completions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Completion"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_delivery_http_projects_create.Request": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "internal_delivery_http_projects_create.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_delivery_http_projects_delete.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_delivery_http_projects_read.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Project"
+                    }
+                }
+            }
+        },
+        "internal_delivery_http_projects_update.Request": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "internal_delivery_http_projects_update.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_delivery_http_tasks_delete.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_delivery_http_tasks_read.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Task"
+                    }
+                }
+            }
+        },
+        "internal_delivery_http_tasks_update.Request": {
+            "type": "object",
+            "required": [
+                "date",
+                "id",
+                "title"
+            ],
+            "properties": {
+                "anchor": {
+                    "type": "string",
+                    "enum": [
+                        "due",
+                        "completion"
+                    ]
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "exdates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1
+                },
+                "project_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "reminders": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "repeat": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "todo",
+                        "in_progress",
+                        "blocked",
+                        "done"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "time": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_delivery_http_tasks_update.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_delivery_http_users_create.Request": {
+            "type": "object",
+            "required": [
+                "name",
+                "password"
+            ],
+            "properties": {
+                "admin": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "IANA time zone reminders are due in",
+                    "type": "string"
+                }
+            }
+        },
+        "internal_delivery_http_users_create.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_delivery_http_users_delete.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_delivery_http_users_read.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.User"
+                    }
+                }
+            }
+        },
+        "internal_delivery_http_users_update.Request": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "admin": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "password": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "IANA time zone reminders are due in",
+                    "type": "string"
+                }
+            }
+        },
+        "internal_delivery_http_users_update.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "mergetags.Request": {
+            "type": "object",
+            "required": [
+                "into",
+                "tags"
+            ],
+            "properties": {
+                "into": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "mergetags.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AuditEntry": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "Name of the user who made the change",
+                    "type": "string"
+                },
+                "after": {
+                    "description": "Task after the change, nil if the change moved it to the trash",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Task"
+                        }
+                    ]
+                },
+                "before": {
+                    "description": "Task before the change, nil for registrations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Task"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "description": "Time of the change in RFC 3339 format, UTC",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "operation": {
+                    "description": "One of the operation kinds: register, update, delete, complete, restore, skip, undo or rollover",
+                    "type": "string"
+                },
+                "task_id": {
+                    "description": "Task the change was made to",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BatchResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "error": {
+                    "description": "Why the operation failed, empty if it succeeded",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Completion": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "description": "Time of completion or of the rollover in RFC 3339 format, UTC",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Scheduled date of the completed occurrence in YYYYMMDD format",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "description": "Whether the occurrence was missed and rolled over instead of completed",
+                    "type": "boolean"
+                },
+                "task_id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Operation": {
+            "type": "object",
+            "properties": {
+                "completion_id": {
+                    "description": "Completion recorded by a complete operation",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "Time of the operation in RFC 3339 format, UTC",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "task": {
+                    "description": "Task as it was before the operation, nil for registrations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Task"
+                        }
+                    ]
+                },
+                "task_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Project": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "description": "Archived projects keep their tasks but take no new ones",
+                    "type": "boolean"
+                },
+                "color": {
+                    "description": "Colour of the project in #RRGGBB format",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Tag": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tasks": {
+                    "description": "Number of tasks outside the trash with the tag",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Task": {
+            "type": "object",
+            "properties": {
+                "anchor": {
+                    "description": "AnchorDue or AnchorCompletion",
+                    "type": "string"
+                },
+                "blocked_by": {
+                    "description": "IDs of the tasks which have to be done before this one",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "blocking": {
+                    "description": "IDs of the tasks waiting for this one to be done",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "children": {
+                    "description": "Subtasks of a top-level task, filled in when a single task is read",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Task"
+                    }
+                },
                 "comment": {
                     "type": "string"
                 },
                 "date": {
                     "type": "string"
                 },
+                "deleted_at": {
+                    "description": "Time the task was moved to the trash in RFC 3339 format, UTC",
+                    "type": "string"
+                },
+                "exdates": {
+                    "description": "Dates in YYYYMMDD format on which a recurring task does not occur",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "id": {
                     "type": "integer"
                 },
+                "occurrence": {
+                    "description": "1-based number of the current occurrence of a recurring task",
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "description": "Task this one is a subtask of, 0 for top-level tasks",
+                    "type": "integer"
+                },
+                "priority": {
+                    "description": "Priority from 1 (urgent) to 4 (low)",
+                    "type": "integer"
+                },
+                "project_id": {
+                    "description": "Project the task belongs to, 0 if none",
+                    "type": "integer"
+                },
+                "reminders": {
+                    "description": "Minutes before the due time at which to remind about the task",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "repeat": {
                     "type": "string"
                 },
+                "status": {
+                    "description": "Workflow status: todo, in_progress, blocked or done",
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "Names of the tags attached to the task in alphabetical order",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "time": {
+                    "description": "Time of day the task is due at in HH:MM format, empty if it is due at the start of its day",
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
+                },
+                "version": {
+                    "description": "Number of the revision of the task, bumped by every update",
+                    "type": "integer"
                 }
             }
         },
-        "read.Response": {
+        "models.User": {
             "type": "object",
             "properties": {
-                "error": {
+                "admin": {
+                    "description": "Whether the user may manage accounts and read the audit log",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "description": "Time the account was created in RFC 3339 format, UTC",
                     "type": "string"
                 },
-                "tasks": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name the user signs in with",
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "IANA time zone the user's tasks are due in, the default one if empty",
+                    "type": "string"
+                }
+            }
+        },
+        "move.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "next.PreviewResponse": {
+            "type": "object",
+            "properties": {
+                "dates": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Task"
+                        "type": "string"
                     }
+                },
+                "error": {
+                    "type": "string"
                 }
             }
         },
         "readone.Response": {
             "type": "object",
             "properties": {
+                "anchor": {
+                    "type": "string"
+                },
+                "blocked_by": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "blocking": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Task"
+                    }
+                },
                 "comment": {
                     "type": "string"
                 },
@@ -310,14 +2355,53 @@ const docTemplate = `{
                 "error": {
                     "type": "string"
                 },
+                "exdates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "id": {
                     "type": "string"
                 },
+                "occurrence": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "project_id": {
+                    "type": "integer"
+                },
+                "reminders": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "repeat": {
                     "type": "string"
                 },
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "time": {
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
@@ -328,15 +2412,68 @@ const docTemplate = `{
                 "title"
             ],
             "properties": {
+                "anchor": {
+                    "type": "string",
+                    "enum": [
+                        "due",
+                        "completion"
+                    ]
+                },
                 "comment": {
                     "type": "string"
                 },
                 "date": {
                     "type": "string"
                 },
+                "exdates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "priority": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1
+                },
+                "project_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "reminders": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "repeat": {
                     "type": "string"
                 },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "todo",
+                        "in_progress",
+                        "blocked",
+                        "done"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "time": {
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
                 }
@@ -353,38 +2490,146 @@ const docTemplate = `{
                 }
             }
         },
-        "update.Request": {
+        "removedependency.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "renametag.Request": {
             "type": "object",
             "required": [
-                "date",
-                "id",
-                "title"
+                "name",
+                "new_name"
             ],
             "properties": {
-                "comment": {
+                "name": {
                     "type": "string"
                 },
-                "date": {
+                "new_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "renametag.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "restore.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "signup.Request": {
+            "type": "object",
+            "required": [
+                "name",
+                "password"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "IANA time zone reminders are due in",
+                    "type": "string"
+                }
+            }
+        },
+        "signup.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
                     "type": "string"
                 },
                 "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "singin.Request": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "name": {
                     "type": "string"
                 },
-                "repeat": {
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "singin.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
                     "type": "string"
                 },
-                "title": {
+                "token": {
                     "type": "string"
                 }
             }
         },
-        "update.Response": {
+        "skip.Response": {
             "type": "object",
             "properties": {
                 "error": {
                     "type": "string"
                 }
             }
+        },
+        "tags.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Tag"
+                    }
+                }
+            }
+        },
+        "trash.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Task"
+                    }
+                }
+            }
+        },
+        "undo.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "operation": {
+                    "$ref": "#/definitions/models.Operation"
+                }
+            }
         }
     }
 }`
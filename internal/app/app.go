@@ -7,25 +7,56 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	trackercfg "github.com/10Narratives/task-tracker/internal/config/tracker"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/audit"
 	mw_auth "github.com/10Narratives/task-tracker/internal/delivery/http/middleware/auth"
 	mw_logging "github.com/10Narratives/task-tracker/internal/delivery/http/middleware/logging"
+	mw_timezone "github.com/10Narratives/task-tracker/internal/delivery/http/middleware/timezone"
 	next "github.com/10Narratives/task-tracker/internal/delivery/http/nextdate"
+	projects_create "github.com/10Narratives/task-tracker/internal/delivery/http/projects/create"
+	projects_delete "github.com/10Narratives/task-tracker/internal/delivery/http/projects/delete"
+	projects_read "github.com/10Narratives/task-tracker/internal/delivery/http/projects/read"
+	projects_update "github.com/10Narratives/task-tracker/internal/delivery/http/projects/update"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/signup"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/singin"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/adddependency"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/batch"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/complete"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/completions"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/delete"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/history"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/mergetags"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/move"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/read"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/readone"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/register"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/removedependency"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/renametag"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/restore"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/skip"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/tags"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/trash"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/undo"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/update"
+	users_create "github.com/10Narratives/task-tracker/internal/delivery/http/users/create"
+	users_delete "github.com/10Narratives/task-tracker/internal/delivery/http/users/delete"
+	users_read "github.com/10Narratives/task-tracker/internal/delivery/http/users/read"
+	users_update "github.com/10Narratives/task-tracker/internal/delivery/http/users/update"
 	"github.com/10Narratives/task-tracker/internal/lib/logging/sl"
+	"github.com/10Narratives/task-tracker/internal/lib/token"
 
+	"github.com/10Narratives/task-tracker/internal/services/nextdate"
 	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/users"
 	"github.com/10Narratives/task-tracker/internal/storage"
 	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/10Narratives/task-tracker/internal/workers/purge"
+	"github.com/10Narratives/task-tracker/internal/workers/remind"
+	"github.com/10Narratives/task-tracker/internal/workers/rollover"
 	"github.com/go-chi/chi/v5"
 	"github.com/joho/godotenv"
 
@@ -70,35 +101,77 @@ func (app *App) Run() {
 		app.logger.Error("can not prepare database:" + err.Error())
 		os.Exit(1)
 	}
-	service := tasks.New(store)
+	location, err := time.LoadLocation(app.cfg.Schedule.Timezone)
+	if err != nil {
+		app.logger.Error("can not load time zone: " + err.Error())
+		os.Exit(1)
+	}
+	var calendar nextdate.Calendar
+	if app.cfg.Schedule.HolidaysFile != "" {
+		calendar, err = nextdate.LoadHolidays(app.cfg.Schedule.HolidaysFile)
+		if err != nil {
+			app.logger.Error("can not load holiday calendar: " + err.Error())
+			os.Exit(1)
+		}
+	}
+	service := tasks.New(store,
+		tasks.WithLocation(location),
+		tasks.WithCalendar(calendar),
+		tasks.WithUndoWindow(app.cfg.Undo.Window),
+		tasks.WithReminderGrace(app.cfg.Reminders.Grace),
+	)
 	app.logger.Info("task service initialized successfully")
 
-	app.logger.Info("starting to initialize router")
-	router := chi.NewRouter()
-	router.Use(mw_logging.New(app.logger))
-
-	router.Handle("/*", http.StripPrefix("/", http.FileServer(http.Dir(app.cfg.HTTP.FileServerPath))))
-
-	router.Post("/api/signin", singin.New(app.logger))
+	app.logger.Info("starting to initialize user service")
+	userService := users.New(store)
+	if password := os.Getenv("PASSWORD"); password != "" {
+		created, err := userService.Bootstrap(context.Background(), password)
+		if err != nil {
+			app.logger.Error("can not create owner account: " + err.Error())
+			os.Exit(1)
+		}
+		if created {
+			app.logger.Info("owner account created from PASSWORD", slog.String("name", users.BootstrapName))
+		}
+	}
+	secret := []byte(app.cfg.Auth.Secret)
+	if len(secret) == 0 {
+		app.logger.Warn("no AUTH_SECRET set, tokens will not survive a restart")
+		if secret, err = token.RandomSecret(); err != nil {
+			app.logger.Error("can not generate token secret: " + err.Error())
+			os.Exit(1)
+		}
+	}
+	tokens := token.New(secret, app.cfg.Auth.TokenTTL)
+	app.logger.Info("user service initialized successfully")
 
-	router.Route("/api", func(r chi.Router) {
-		r.Use(mw_auth.Auth)
-
-		router.Post("/api/task", register.New(app.logger, service))
-		router.Get("/api/tasks", read.New(app.logger, service))
-		router.Get("/api/task", readone.New(app.logger, service))
-		router.Put("/api/task", update.New(app.logger, service))
-		router.Delete("/api/task", delete.New(app.logger, service))
-		router.Post("/api/task/done", complete.New(app.logger, service))
-		router.Delete("/api/task/done", delete.New(app.logger, service))
-	})
+	// The workers are stopped and waited for before the deferred close of the database,
+	// so that none of them is left writing to a closed connection.
+	var workers sync.WaitGroup
+	defer workers.Wait()
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	startWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workerCtx)
+		}()
+	}
 
-	router.Get("/api/nextdate", next.New(app.logger))
+	startWorker(purge.New(app.logger, service, app.cfg.Trash.Retention, app.cfg.Trash.PurgeInterval).Run)
+	if app.cfg.Rollover.Enabled {
+		startWorker(rollover.New(app.logger, service, app.cfg.Rollover.Interval).Run)
+	}
 
-	router.Get("/swagger/*", httpSwagger.Handler(
-		httpSwagger.URL("http://localhost:8000/swagger/doc.json"),
-	))
+	notifiers := []remind.Notifier{remind.NewLogNotifier(app.logger)}
+	if app.cfg.Reminders.WebhookURL != "" {
+		notifiers = append(notifiers, remind.NewWebhookNotifier(app.cfg.Reminders.WebhookURL, &http.Client{Timeout: app.cfg.HTTP.Timeout}))
+	}
+	startWorker(remind.New(app.logger, service, app.cfg.Reminders.Interval, notifiers...).Run)
 
+	app.logger.Info("starting to initialize router")
+	router := app.router(service, userService, tokens, calendar)
 	app.logger.Info("router initialized successfully")
 
 	srv := &http.Server{
@@ -122,6 +195,7 @@ func (app *App) Run() {
 
 	<-done
 	app.logger.Info("stopping server")
+	stopWorkers()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -134,3 +208,61 @@ func (app *App) Run() {
 
 	app.logger.Info("server stopped")
 }
+
+// router registers the handlers of the API, the web interface and the API docs.
+// Every /api route except signing in, signing up and the next date calculator requires authentication.
+func (app *App) router(service tasks.TaskService, userService users.UserService, tokens token.Issuer, calendar nextdate.Calendar) http.Handler {
+	router := chi.NewRouter()
+	router.Use(mw_logging.New(app.logger))
+	router.Use(mw_timezone.Timezone)
+
+	router.Handle("/*", http.StripPrefix("/", http.FileServer(http.Dir(app.cfg.HTTP.FileServerPath))))
+
+	router.Post("/api/signin", singin.New(app.logger, userService, tokens))
+	if app.cfg.Auth.Signup {
+		router.Post("/api/signup", signup.New(app.logger, userService))
+	}
+
+	router.Route("/api", func(r chi.Router) {
+		r.Use(mw_auth.Auth(tokens, userService))
+
+		r.Post("/task", register.New(app.logger, service))
+		r.Get("/tasks", read.New(app.logger, service))
+		r.Post("/tasks/batch", batch.New(app.logger, service))
+		r.Get("/task", readone.New(app.logger, service))
+		r.Put("/task", update.New(app.logger, service))
+		r.Delete("/task", delete.New(app.logger, service))
+		r.Post("/task/done", complete.New(app.logger, service))
+		r.Post("/task/skip", skip.New(app.logger, service))
+		r.Get("/task/history", history.New(app.logger, service))
+		r.Get("/completions", completions.New(app.logger, service))
+		r.Get("/trash", trash.New(app.logger, service))
+		r.Post("/trash/restore", restore.New(app.logger, service))
+		r.Post("/undo", undo.New(app.logger, service))
+		r.Get("/tags", tags.New(app.logger, service))
+		r.Post("/tags/rename", renametag.New(app.logger, service))
+		r.Post("/tags/merge", mergetags.New(app.logger, service))
+		r.Post("/task/move", move.New(app.logger, service))
+		r.Post("/task/dependency", adddependency.New(app.logger, service))
+		r.Delete("/task/dependency", removedependency.New(app.logger, service))
+		r.Get("/projects", projects_read.New(app.logger, service))
+		r.Post("/projects", projects_create.New(app.logger, service))
+		r.Put("/projects", projects_update.New(app.logger, service))
+		r.Delete("/projects", projects_delete.New(app.logger, service))
+		r.Delete("/task/done", delete.New(app.logger, service))
+		r.With(mw_auth.AdminOnly).Get("/audit", audit.New(app.logger, service))
+		r.With(mw_auth.AdminOnly).Get("/users", users_read.New(app.logger, userService))
+		r.With(mw_auth.AdminOnly).Post("/users", users_create.New(app.logger, userService))
+		r.With(mw_auth.AdminOnly).Put("/users", users_update.New(app.logger, userService))
+		r.With(mw_auth.AdminOnly).Delete("/users", users_delete.New(app.logger, userService))
+	})
+
+	router.Get("/api/nextdate", next.New(app.logger, calendar))
+	router.Get("/api/nextdate/preview", next.NewPreview(app.logger, calendar))
+
+	router.Get("/swagger/*", httpSwagger.Handler(
+		httpSwagger.URL("http://localhost:8000/swagger/doc.json"),
+	))
+
+	return router
+}
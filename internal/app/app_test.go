@@ -0,0 +1,102 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	trackercfg "github.com/10Narratives/task-tracker/internal/config/tracker"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/lib/token"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	tasks_mocks "github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/10Narratives/task-tracker/internal/services/users"
+	users_mocks "github.com/10Narratives/task-tracker/internal/services/users/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestRouter_RequiresAuthentication(t *testing.T) {
+	t.Parallel()
+
+	app := App{cfg: &trackercfg.Config{}, logger: slogdiscard.NewDiscardLogger()}
+	router := app.router(
+		tasks.New(tasks_mocks.NewTaskStorage(t)),
+		users.New(users_mocks.NewUserStorage(t)),
+		token.New([]byte("secret"), time.Hour),
+		nil,
+	)
+
+	tests := []struct {
+		method string
+		target string
+	}{
+		{http.MethodPost, "/api/task"},
+		{http.MethodGet, "/api/task?id=1"},
+		{http.MethodPut, "/api/task"},
+		{http.MethodDelete, "/api/task?id=1"},
+		{http.MethodGet, "/api/tasks"},
+		{http.MethodPost, "/api/task/done?id=1"},
+		{http.MethodGet, "/api/trash"},
+		{http.MethodGet, "/api/projects"},
+		{http.MethodGet, "/api/audit"},
+		{http.MethodGet, "/api/users"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.method+" "+tc.target, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tc.method, tc.target, nil)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		})
+	}
+}
+
+func TestRouter_SignIn(t *testing.T) {
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{name: "right password", body: `{"password":"secret"}`, wantStatus: http.StatusOK},
+		{name: "wrong password", body: `{"password":"guess"}`, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := users_mocks.NewUserStorage(t)
+			storage.On("ReadUserByName", mock.Anything, users.BootstrapName).
+				Return(models.User{ID: 1, Name: users.BootstrapName, Admin: true, PasswordHash: string(hash)}, nil)
+
+			app := App{cfg: &trackercfg.Config{}, logger: slogdiscard.NewDiscardLogger()}
+			router := app.router(tasks.New(tasks_mocks.NewTaskStorage(t)), users.New(storage), token.New([]byte("secret"), time.Hour), nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/signin", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
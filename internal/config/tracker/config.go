@@ -11,9 +11,15 @@ import (
 // It contains nested configurations for storage, HTTP server, and logging components.
 // Fields are loaded from YAML configuration files and can be overridden by environment variables.
 type Config struct {
-	Storage StorageConfig          `yaml:"storage"`     // Database storage configuration
-	HTTP    HTTPServerConfig       `yaml:"http_server"` // HTTP server configuration
-	Logger  commoncfg.LoggerConfig `yaml:"logging"`     // Logging system configuration
+	Storage   StorageConfig          `yaml:"storage"`     // Database storage configuration
+	HTTP      HTTPServerConfig       `yaml:"http_server"` // HTTP server configuration
+	Logger    commoncfg.LoggerConfig `yaml:"logging"`     // Logging system configuration
+	Schedule  ScheduleConfig         `yaml:"schedule"`    // Task scheduling configuration
+	Trash     TrashConfig            `yaml:"trash"`       // Deleted task retention configuration
+	Undo      UndoConfig             `yaml:"undo"`        // Undo history configuration
+	Reminders RemindersConfig        `yaml:"reminders"`   // Task reminder delivery configuration
+	Rollover  RolloverConfig         `yaml:"rollover"`    // Overdue recurring task rollover configuration
+	Auth      AuthConfig             `yaml:"auth"`        // User account and token configuration
 }
 
 // StorageConfig defines parameters for database connection and operation.
@@ -32,6 +38,43 @@ type HTTPServerConfig struct {
 	FileServerPath string        `yaml:"file_server_path" env-default:"./web"` // Path to static web assets directory
 }
 
+// ScheduleConfig defines how task dates are calculated.
+type ScheduleConfig struct {
+	Timezone     string `yaml:"timezone" env-default:"UTC"` // Default IANA time zone in which "today" is evaluated
+	HolidaysFile string `yaml:"holidays_file"`              // Optional holiday calendar (.ics, .yaml) for working day rules
+}
+
+// TrashConfig defines how long deleted tasks are kept before they are purged.
+type TrashConfig struct {
+	Retention     time.Duration `yaml:"retention" env-default:"720h"`    // Time a deleted task stays restorable
+	PurgeInterval time.Duration `yaml:"purge_interval" env-default:"1h"` // Interval between purges of expired tasks
+}
+
+// UndoConfig defines for how long operations on tasks can be undone.
+type UndoConfig struct {
+	Window time.Duration `yaml:"window" env-default:"10m"` // Time during which an operation can be undone
+}
+
+// RemindersConfig defines when and where task reminders are delivered.
+type RemindersConfig struct {
+	Interval   time.Duration `yaml:"interval" env-default:"1m"` // Longest time between checks for new or changed reminders
+	Grace      time.Duration `yaml:"grace" env-default:"1h"`    // How late a missed reminder is still sent, e.g. after a restart
+	WebhookURL string        `yaml:"webhook_url"`               // Optional URL reminders are posted to as JSON
+}
+
+// RolloverConfig defines whether and how often overdue recurring tasks are moved to their next occurrence.
+type RolloverConfig struct {
+	Enabled  bool          `yaml:"enabled" env-default:"true"` // Whether overdue recurring tasks are rolled over
+	Interval time.Duration `yaml:"interval" env-default:"1h"`  // Interval between rollovers
+}
+
+// AuthConfig defines how users sign up and how the tokens they sign in with are signed.
+type AuthConfig struct {
+	Secret   string        `yaml:"secret" env:"AUTH_SECRET"`     // Key tokens are signed with; a random one is used if empty
+	TokenTTL time.Duration `yaml:"token_ttl" env-default:"168h"` // Time a token stays valid after signing in
+	Signup   bool          `yaml:"signup" env-default:"false"`   // Whether anyone may create an account at /api/signup
+}
+
 var loader = config.ConfigLoader[Config]{}
 
 // MustLoad loads configuration using the default loader instance.
@@ -0,0 +1,120 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Audit"
+
+type Response struct {
+	Entries []models.AuditEntry `json:"entries,omitempty"`
+	Err     string              `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=AuditReader
+type AuditReader interface {
+	AuditLog(ctx context.Context, from, to string, filter models.AuditFilter) ([]models.AuditEntry, error)
+}
+
+var operations = []string{
+	models.OperationRegister, models.OperationUpdate, models.OperationDelete, models.OperationComplete,
+	models.OperationRestore, models.OperationSkip, models.OperationUndo, models.OperationRollover,
+}
+
+// parseFilter reads the actor, operation, task_id, limit and offset query parameters
+// and checks the from and to dates, which are returned as they are.
+func parseFilter(query url.Values) (from, to string, filter models.AuditFilter, err error) {
+	filter.Actor = query.Get("actor")
+
+	filter.Operation = query.Get("operation")
+	if filter.Operation != "" && !slices.Contains(operations, filter.Operation) {
+		return "", "", models.AuditFilter{}, errors.New("field operation must be one of: register, update, delete, complete, restore, skip, undo, rollover")
+	}
+
+	if task := query.Get("task_id"); task != "" {
+		id, err := strconv.ParseInt(task, 10, 64)
+		if err != nil || id < 1 {
+			return "", "", models.AuditFilter{}, errors.New("field task_id must be a positive number")
+		}
+		filter.TaskID = id
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 {
+			return "", "", models.AuditFilter{}, errors.New("field limit must be a positive number")
+		}
+		filter.Limit = n
+	}
+
+	if offset := query.Get("offset"); offset != "" {
+		n, err := strconv.Atoi(offset)
+		if err != nil || n < 0 {
+			return "", "", models.AuditFilter{}, errors.New("field offset must not be a negative number")
+		}
+		filter.Offset = n
+	}
+
+	from, to = query.Get("from"), query.Get("to")
+	for _, param := range []struct{ name, value string }{{"from", from}, {"to", to}} {
+		if param.value == "" {
+			continue
+		}
+		if _, err := time.Parse(lib.DateFormat, param.value); err != nil {
+			return "", "", models.AuditFilter{}, errors.New("field " + param.name + " must be in YYYYMMDD date format")
+		}
+	}
+
+	return from, to, filter, nil
+}
+
+// @Summary Get the audit log
+// @Description Retrieve a page of the changes made to tasks, most recent first. Available to admins only
+// @Produce json
+// @Param actor query string false "Name of the user who made the changes"
+// @Param operation query string false "Kind of the changes" Enums(register, update, delete, complete, restore, skip, undo, rollover)
+// @Param task_id query int false "Task ID"
+// @Param from query string false "First day in YYYYMMDD format"
+// @Param to query string false "Last day in YYYYMMDD format"
+// @Param limit query int false "Maximum number of entries on the page, capped by the pagination limit"
+// @Param offset query int false "Number of entries skipped before the page"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid filter"
+// @Failure 403 {string} string "Admin rights required"
+// @Failure 500 {object} Response "Failed to read audit log"
+// @Router /api/audit [get]
+func New(log *slog.Logger, ar AuditReader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		from, to, filter, err := parseFilter(r.URL.Query())
+		if err != nil {
+			logger.Error("invalid filter", slog.String("error", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: err.Error()})
+			return
+		}
+
+		entries, err := ar.AuditLog(r.Context(), from, to, filter)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, Response{Err: "failed to read audit log"})
+			return
+		}
+
+		logger.Info("audit log was read")
+		render.JSON(w, r, Response{Entries: entries})
+	}
+}
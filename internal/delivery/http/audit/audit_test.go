@@ -0,0 +1,122 @@
+package audit_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/audit"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/audit/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditHandler(t *testing.T) {
+	entries := []models.AuditEntry{
+		{ID: 2, Actor: "owner", Operation: "delete", TaskID: 7, Before: &models.Task{ID: 7, Date: "20250410", Title: "Task"}, CreatedAt: "2025-04-10T10:31:00Z"},
+		{ID: 1, Actor: "owner", Operation: "register", TaskID: 7, After: &models.Task{ID: 7, Date: "20250410", Title: "Task"}, CreatedAt: "2025-04-10T10:30:00Z"},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(m *mocks.AuditReader)
+		wantStatus int
+		wantResp   audit.Response
+	}{
+		{
+			name:  "successful reading - whole log",
+			query: "",
+			mockSetup: func(m *mocks.AuditReader) {
+				m.On("AuditLog", mock.Anything, "", "", models.AuditFilter{}).Return(entries, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   audit.Response{Entries: entries},
+		},
+		{
+			name:  "successful reading - filtered page",
+			query: "?actor=owner&operation=delete&task_id=7&from=20250401&to=20250410&limit=10&offset=20",
+			mockSetup: func(m *mocks.AuditReader) {
+				m.On("AuditLog", mock.Anything, "20250401", "20250410", models.AuditFilter{Actor: "owner", Operation: "delete", TaskID: 7, Limit: 10, Offset: 20}).
+					Return(entries[:1], nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   audit.Response{Entries: entries[:1]},
+		},
+		{
+			name:       "unsuccessful reading - unknown operation",
+			query:      "?operation=rename",
+			mockSetup:  func(m *mocks.AuditReader) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   audit.Response{Err: "field operation must be one of: register, update, delete, complete, restore, skip, undo, rollover"},
+		},
+		{
+			name:       "unsuccessful reading - invalid task id",
+			query:      "?task_id=seven",
+			mockSetup:  func(m *mocks.AuditReader) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   audit.Response{Err: "field task_id must be a positive number"},
+		},
+		{
+			name:       "unsuccessful reading - invalid limit",
+			query:      "?limit=0",
+			mockSetup:  func(m *mocks.AuditReader) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   audit.Response{Err: "field limit must be a positive number"},
+		},
+		{
+			name:       "unsuccessful reading - negative offset",
+			query:      "?offset=-1",
+			mockSetup:  func(m *mocks.AuditReader) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   audit.Response{Err: "field offset must not be a negative number"},
+		},
+		{
+			name:       "unsuccessful reading - invalid date",
+			query:      "?from=2025-04-01",
+			mockSetup:  func(m *mocks.AuditReader) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   audit.Response{Err: "field from must be in YYYYMMDD date format"},
+		},
+		{
+			name:  "unsuccessful reading - database error",
+			query: "",
+			mockSetup: func(m *mocks.AuditReader) {
+				m.On("AuditLog", mock.Anything, "", "", models.AuditFilter{}).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   audit.Response{Err: "failed to read audit log"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewAuditReader(t)
+			tc.mockSetup(mock)
+
+			handler := audit.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/audit"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Get(`/api/audit`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp audit.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+			mock.AssertExpectations(t)
+		})
+	}
+}
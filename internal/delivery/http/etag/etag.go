@@ -0,0 +1,40 @@
+// Package etag converts task versions to and from the entity tags of the ETag and If-Match headers.
+package etag
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalid is returned for an If-Match header which does not hold a task version.
+var ErrInvalid = errors.New("If-Match header must hold the ETag of a task")
+
+// Format returns the strong entity tag of a task version.
+func Format(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// Parse returns the task version held by an If-Match header.
+// An empty header and "*" match any version, which is reported as 0.
+func Parse(header string) (int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	unquoted, ok := strings.CutPrefix(header, `"`)
+	if !ok {
+		return 0, ErrInvalid
+	}
+	unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	if !ok {
+		return 0, ErrInvalid
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version < 1 {
+		return 0, ErrInvalid
+	}
+	return version, nil
+}
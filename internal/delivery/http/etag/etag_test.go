@@ -0,0 +1,46 @@
+package etag_test
+
+import (
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/etag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `"7"`, etag.Format(7))
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		header      string
+		wantVersion int64
+		wantErr     error
+	}{
+		{name: "no header", header: "", wantVersion: 0},
+		{name: "any version", header: "*", wantVersion: 0},
+		{name: "version", header: `"7"`, wantVersion: 7},
+		{name: "surrounding spaces", header: ` "7" `, wantVersion: 7},
+		{name: "weak tag", header: `W/"7"`, wantErr: etag.ErrInvalid},
+		{name: "unquoted", header: "7", wantErr: etag.ErrInvalid},
+		{name: "not a number", header: `"abc"`, wantErr: etag.ErrInvalid},
+		{name: "zero", header: `"0"`, wantErr: etag.ErrInvalid},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			version, err := etag.Parse(tc.header)
+			assert.Equal(t, tc.wantVersion, version)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -1,49 +1,90 @@
 package mw_auth
 
 import (
+	"context"
 	"errors"
 	"net/http"
-	"os"
+	"strings"
+	"time"
 
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/10Narratives/task-tracker/internal/lib/identity"
+	"github.com/10Narratives/task-tracker/internal/lib/timezone"
+	"github.com/10Narratives/task-tracker/internal/lib/token"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
 )
 
-func Auth(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		pass := os.Getenv("PASSWORD")
-		if pass == "" {
-			next.ServeHTTP(w, r)
-			return
-		}
+// UserReader looks up the account a token was issued for.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=UserReader
+type UserReader interface {
+	User(ctx context.Context, id int64) (models.User, error)
+}
 
-		cookie, err := r.Cookie("token")
-		if err != nil {
-			http.Error(w, "Authentication required", http.StatusUnauthorized)
-			return
-		}
+// Auth returns a middleware which lets through the requests carrying a valid token, either in the token cookie
+// or as a bearer token in the Authorization header, and stores the account they are made on behalf of in the request context.
+// The account is read on every request, so that removed accounts and withdrawn admin rights take effect at once.
+// The time zone of the account is stored as well, unless the request already carries one, e.g. from the X-Timezone header.
+func Auth(tokens token.Issuer, users UserReader) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenString := bearerToken(r)
+			if tokenString == "" {
+				if cookie, err := r.Cookie("token"); err == nil {
+					tokenString = cookie.Value
+				}
+			}
+			if tokenString == "" {
+				http.Error(w, "Authentication required", http.StatusUnauthorized)
+				return
+			}
 
-		tokenString := cookie.Value
-		_, err = validateToken(tokenString, pass)
-		if err != nil {
-			http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
-			return
-		}
+			userID, err := tokens.Parse(tokenString)
+			if err != nil {
+				http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
+				return
+			}
 
-		next.ServeHTTP(w, r)
-	})
-}
+			user, err := users.User(r.Context(), userID)
+			if errors.Is(err, domain.ErrNotFound) {
+				http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
+				return
+			}
+			if err != nil {
+				http.Error(w, "Failed to authenticate", http.StatusInternalServerError)
+				return
+			}
 
-func validateToken(tokenString, secret string) (*jwt.Token, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
-		return []byte(secret), nil
-	})
+			ctx := identity.WithUser(r.Context(), identity.User{ID: user.ID, Name: user.Name, Admin: user.Admin})
+			if _, ok := timezone.FromContext(ctx); !ok && user.Timezone != "" {
+				if loc, err := time.LoadLocation(user.Timezone); err == nil {
+					ctx = timezone.WithLocation(ctx, loc)
+				}
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
 
-	if err != nil || !token.Valid {
-		return nil, errors.New("invalid token")
+// bearerToken returns the token of the Authorization header in the "Bearer <token>" form, if any.
+func bearerToken(r *http.Request) string {
+	scheme, tokenString, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
 	}
+	return strings.TrimSpace(tokenString)
+}
 
-	return token, nil
+// AdminOnly lets through the requests made on behalf of an admin and answers the others with 403 Forbidden.
+// It is used after Auth, which puts the user into the request context.
+func AdminOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := identity.FromContext(r.Context())
+		if !ok || !user.Admin {
+			http.Error(w, "Admin rights required", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
 }
@@ -0,0 +1,177 @@
+package mw_auth_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mw_auth "github.com/10Narratives/task-tracker/internal/delivery/http/middleware/auth"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/middleware/auth/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/identity"
+	"github.com/10Narratives/task-tracker/internal/lib/timezone"
+	"github.com/10Narratives/task-tracker/internal/lib/token"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuth(t *testing.T) {
+	tokens := token.New([]byte("secret"), time.Hour)
+	valid, err := tokens.Issue(3)
+	require.NoError(t, err)
+	alice := models.User{ID: 3, Name: "alice", Admin: true}
+	bob := models.User{ID: 3, Name: "bob", Timezone: "Europe/Berlin"}
+
+	tests := []struct {
+		name       string
+		mockSetup  func(m *mocks.UserReader)
+		cookie     string
+		header     string
+		zone       string
+		wantStatus int
+		wantUser   identity.User
+		wantZone   string
+	}{
+		{
+			name: "token in cookie",
+			mockSetup: func(m *mocks.UserReader) {
+				m.On("User", mock.Anything, int64(3)).Return(alice, nil)
+			},
+			cookie:     valid,
+			wantStatus: http.StatusOK,
+			wantUser:   identity.User{ID: 3, Name: "alice", Admin: true},
+		},
+		{
+			name: "bearer token",
+			mockSetup: func(m *mocks.UserReader) {
+				m.On("User", mock.Anything, int64(3)).Return(alice, nil)
+			},
+			header:     "Bearer " + valid,
+			wantStatus: http.StatusOK,
+			wantUser:   identity.User{ID: 3, Name: "alice", Admin: true},
+		},
+		{
+			name: "time zone of the account",
+			mockSetup: func(m *mocks.UserReader) {
+				m.On("User", mock.Anything, int64(3)).Return(bob, nil)
+			},
+			cookie:     valid,
+			wantStatus: http.StatusOK,
+			wantUser:   identity.User{ID: 3, Name: "bob"},
+			wantZone:   "Europe/Berlin",
+		},
+		{
+			name: "time zone of the request takes precedence",
+			mockSetup: func(m *mocks.UserReader) {
+				m.On("User", mock.Anything, int64(3)).Return(bob, nil)
+			},
+			cookie:     valid,
+			zone:       "America/New_York",
+			wantStatus: http.StatusOK,
+			wantUser:   identity.User{ID: 3, Name: "bob"},
+			wantZone:   "America/New_York",
+		},
+		{
+			name:       "no token",
+			mockSetup:  func(m *mocks.UserReader) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid token",
+			mockSetup:  func(m *mocks.UserReader) {},
+			cookie:     "not a token",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "account removed",
+			mockSetup: func(m *mocks.UserReader) {
+				m.On("User", mock.Anything, int64(3)).Return(models.User{}, fmt.Errorf("%w: 3", users.ErrUserNotFound))
+			},
+			cookie:     valid,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "database error",
+			mockSetup: func(m *mocks.UserReader) {
+				m.On("User", mock.Anything, int64(3)).Return(models.User{}, errors.New("database error"))
+			},
+			cookie:     valid,
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reader := mocks.NewUserReader(t)
+			tc.mockSetup(reader)
+
+			var (
+				gotUser identity.User
+				gotZone string
+			)
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUser, _ = identity.FromContext(r.Context())
+				if loc, ok := timezone.FromContext(r.Context()); ok {
+					gotZone = loc.String()
+				}
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+			if tc.zone != "" {
+				loc, err := time.LoadLocation(tc.zone)
+				require.NoError(t, err)
+				req = req.WithContext(timezone.WithLocation(req.Context(), loc))
+			}
+			if tc.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "token", Value: tc.cookie})
+			}
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			rec := httptest.NewRecorder()
+			mw_auth.Auth(tokens, reader)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantUser, gotUser)
+			assert.Equal(t, tc.wantZone, gotZone)
+		})
+	}
+}
+
+func TestAdminOnly(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       *identity.User
+		wantStatus int
+	}{
+		{name: "admin", user: &identity.User{ID: 1, Name: "owner", Admin: true}, wantStatus: http.StatusOK},
+		{name: "regular user", user: &identity.User{ID: 3, Name: "alice"}, wantStatus: http.StatusForbidden},
+		{name: "no user", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/audit", nil)
+			if tc.user != nil {
+				req = req.WithContext(identity.WithUser(req.Context(), *tc.user))
+			}
+			rec := httptest.NewRecorder()
+			mw_auth.AdminOnly(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package mw_timezone
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib/timezone"
+)
+
+const (
+	Header     = "X-Timezone" // Request header carrying an IANA time zone name
+	QueryParam = "tz"         // Query parameter carrying an IANA time zone name
+)
+
+// Timezone reads the user's time zone from the X-Timezone header or the tz query parameter
+// and stores it in the request context. Requests without a zone are passed through unchanged,
+// so services fall back to the configured default zone.
+func Timezone(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.Header.Get(Header)
+		if name == "" {
+			name = r.URL.Query().Get(QueryParam)
+		}
+		if name == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			http.Error(w, "Unknown time zone", http.StatusBadRequest)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(timezone.WithLocation(r.Context(), loc)))
+	})
+}
@@ -25,7 +25,7 @@ type Response struct {
 	Err      string `json:"error,omitempty"`
 }
 
-func New(logger *slog.Logger) http.HandlerFunc {
+func New(logger *slog.Logger, cal nextdate.Calendar) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		params := URLParams{
 			Now:    r.URL.Query().Get("now"),
@@ -49,8 +49,16 @@ func New(logger *slog.Logger) http.HandlerFunc {
 		logger.Info("getting nextdate")
 
 		now, _ := time.Parse(lib.DateFormat, params.Now)
-		date, _ := time.Parse(lib.DateFormat, params.Now)
-		nextdate := nextdate.NextDate(now, date, params.Repeat)
+		date, _ := time.Parse(lib.DateFormat, params.Date)
+		nextdate, err := nextdate.NextDate(now, date, params.Repeat, nextdate.WithCalendar(cal))
+		if err != nil {
+			logger.Error("can not calculate nextdate", slog.String("error", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: err.Error()})
+
+			return
+		}
+
 		render.JSON(w, r, Response{NextDate: nextdate})
 	}
 }
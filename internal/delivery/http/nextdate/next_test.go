@@ -30,6 +30,14 @@ func TestNextDateHandler(t *testing.T) {
 			wantStatus: http.StatusOK,
 			wantResp:   next.Response{NextDate: "20250220"},
 		},
+		{
+			name:       "m 2:2",
+			now:        "20250210",
+			date:       "20250114",
+			repeat:     "m 2:2",
+			wantStatus: http.StatusOK,
+			wantResp:   next.Response{NextDate: "20250211"},
+		},
 		{
 			name:       "invalid dateformat",
 			now:        "2025-02-10",
@@ -54,7 +62,7 @@ func TestNextDateHandler(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			handler := next.New(slogdiscard.NewDiscardLogger())
+			handler := next.New(slogdiscard.NewDiscardLogger(), nil)
 			pattern := "/api/nextdate"
 			url := pattern + "?now=" + tc.now + "&date=" + tc.date + "&repeat=" + url.QueryEscape(tc.repeat)
 
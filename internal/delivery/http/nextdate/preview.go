@@ -0,0 +1,94 @@
+package next
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/10Narratives/task-tracker/internal/lib"
+	"github.com/10Narratives/task-tracker/internal/services/nextdate"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	DefaultPreviewCount = 5  // Number of dates returned when count is omitted
+	MaxPreviewCount     = 50 // Upper bound for the count parameter
+)
+
+type PreviewParams struct {
+	Date   string `json:"date" validate:"required,dateformat"`
+	Repeat string `json:"repeat" validate:"required,repeat"`
+	Count  int    `json:"count" validate:"min=1,max=50"`
+}
+
+type PreviewResponse struct {
+	Dates []string `json:"dates,omitempty"`
+	Err   string   `json:"error,omitempty"`
+}
+
+// @Summary Preview repeat rule
+// @Description List the upcoming dates produced by a repeat rule
+// @Produce json
+// @Param date query string true "Start date in YYYYMMDD format"
+// @Param repeat query string true "Repeat rule"
+// @Param count query int false "Number of dates to list (1-50, default 5)"
+// @Success 200 {object} PreviewResponse
+// @Failure 400 {object} PreviewResponse "Invalid request parameters"
+// @Router /api/nextdate/preview [get]
+func NewPreview(logger *slog.Logger, cal nextdate.Calendar) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := PreviewParams{
+			Date:   r.URL.Query().Get("date"),
+			Repeat: r.URL.Query().Get("repeat"),
+			Count:  DefaultPreviewCount,
+		}
+
+		if count := r.URL.Query().Get("count"); count != "" {
+			var err error
+			params.Count, err = strconv.Atoi(count)
+			if err != nil {
+				logger.Error("invalid request")
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, PreviewResponse{Err: "field Count must be an integer"})
+
+				return
+			}
+		}
+
+		v := validator.New()
+		v.RegisterValidation("dateformat", validation.IsDateValid)
+		v.RegisterValidation("repeat", validation.IsRepeatValid)
+		if err := v.Struct(params); err != nil {
+			validationErr := err.(validator.ValidationErrors)
+
+			logger.Error("invalid request")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, PreviewResponse{Err: validation.ValidationErrorMsg(validationErr)})
+
+			return
+		}
+
+		rule, err := nextdate.Parse(params.Repeat)
+		if err != nil {
+			logger.Error("can not parse repeat rule", slog.String("error", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, PreviewResponse{Err: err.Error()})
+
+			return
+		}
+
+		logger.Info("previewing repeat rule")
+
+		date, _ := time.Parse(lib.DateFormat, params.Date)
+		occurrences := nextdate.Occurrences(date, rule, params.Count, nextdate.WithCalendar(cal))
+		dates := make([]string, len(occurrences))
+		for i, occurrence := range occurrences {
+			dates[i] = occurrence.Format(lib.DateFormat)
+		}
+
+		render.JSON(w, r, PreviewResponse{Dates: dates})
+	}
+}
@@ -0,0 +1,89 @@
+package next_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	next "github.com/10Narratives/task-tracker/internal/delivery/http/nextdate"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      url.Values
+		wantStatus int
+		wantResp   next.PreviewResponse
+	}{
+		{
+			name:       "explicit count",
+			query:      url.Values{"date": {"20250210"}, "repeat": {"d 10"}, "count": {"3"}},
+			wantStatus: http.StatusOK,
+			wantResp:   next.PreviewResponse{Dates: []string{"20250220", "20250302", "20250312"}},
+		},
+		{
+			name:       "default count",
+			query:      url.Values{"date": {"20250101"}, "repeat": {"m 2:2"}},
+			wantStatus: http.StatusOK,
+			wantResp:   next.PreviewResponse{Dates: []string{"20250114", "20250211", "20250311", "20250408", "20250513"}},
+		},
+		{
+			name:       "count is not a number",
+			query:      url.Values{"date": {"20250210"}, "repeat": {"d 10"}, "count": {"ten"}},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   next.PreviewResponse{Err: "field Count must be an integer"},
+		},
+		{
+			name:       "count above cap",
+			query:      url.Values{"date": {"20250210"}, "repeat": {"d 10"}, "count": {"51"}},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   next.PreviewResponse{Err: "field Count must be at most 50"},
+		},
+		{
+			name:       "count below one",
+			query:      url.Values{"date": {"20250210"}, "repeat": {"d 10"}, "count": {"0"}},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   next.PreviewResponse{Err: "field Count must be at least 1"},
+		},
+		{
+			name:       "missing repeat",
+			query:      url.Values{"date": {"20250210"}},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   next.PreviewResponse{Err: "field Repeat is required"},
+		},
+		{
+			name:       "invalid repeat and date",
+			query:      url.Values{"date": {"2025-02-10"}, "repeat": {"d 1000"}},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   next.PreviewResponse{Err: "field Date must be in YYYYMMDD date format, field Repeat must satisfy expected patterns"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := next.NewPreview(slogdiscard.NewDiscardLogger(), nil)
+			pattern := "/api/nextdate/preview"
+
+			req := httptest.NewRequest(http.MethodGet, pattern+"?"+tc.query.Encode(), nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Get(pattern, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp next.PreviewResponse
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package create
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const op = "http.CreateProject"
+
+type Request struct {
+	Name  string `json:"name" validate:"required,max=64"`
+	Color string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+}
+
+type Response struct {
+	ID  int64  `json:"id,omitempty"`
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=ProjectCreator
+type ProjectCreator interface {
+	CreateProject(ctx context.Context, project models.Project) (int64, error)
+}
+
+// @Summary Create a project
+// @Description Add a new project to keep a separate list of tasks
+// @Accept json
+// @Produce json
+// @Param request body Request true "Project data"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 500 {object} Response "Failed to create project"
+// @Router /api/projects [post]
+func New(log *slog.Logger, pc ProjectCreator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		var req Request
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			logger.Error("failed to decode request body")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "failed to decode request body"})
+			return
+		}
+
+		if err := validator.New().Struct(req); err != nil {
+			logger.Error("invalid request")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(err.(validator.ValidationErrors))})
+			return
+		}
+
+		id, err := pc.CreateProject(r.Context(), models.Project{Name: req.Name, Color: req.Color})
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, Response{Err: "failed to create project"})
+			return
+		}
+
+		logger.Info("project was created", slog.Int64("id", id))
+		render.JSON(w, r, Response{ID: id})
+	}
+}
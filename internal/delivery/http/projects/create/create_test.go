@@ -0,0 +1,87 @@
+package create_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/projects/create"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/projects/create/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateProjectHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestBody string
+		mockSetup   func(m *mocks.ProjectCreator)
+		wantStatus  int
+		wantResp    create.Response
+	}{
+		{
+			name:        "successful creation",
+			requestBody: `{"name":"Garden","color":"#2e8b57"}`,
+			mockSetup: func(m *mocks.ProjectCreator) {
+				m.On("CreateProject", mock.Anything, models.Project{Name: "Garden", Color: "#2e8b57"}).Return(int64(2), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   create.Response{ID: 2},
+		},
+		{
+			name:        "unsuccessful creation - missing name",
+			requestBody: `{"color":"#2e8b57"}`,
+			mockSetup:   func(m *mocks.ProjectCreator) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    create.Response{Err: "field Name is required"},
+		},
+		{
+			name:        "unsuccessful creation - invalid colour",
+			requestBody: `{"name":"Garden","color":"green"}`,
+			mockSetup:   func(m *mocks.ProjectCreator) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    create.Response{Err: "field Color must be a colour in #RRGGBB format"},
+		},
+		{
+			name:        "unsuccessful creation - database error",
+			requestBody: `{"name":"Garden"}`,
+			mockSetup: func(m *mocks.ProjectCreator) {
+				m.On("CreateProject", mock.Anything, models.Project{Name: "Garden"}).Return(int64(0), errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   create.Response{Err: "failed to create project"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewProjectCreator(t)
+			tc.mockSetup(mock)
+
+			handler := create.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/projects", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/projects`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp create.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package delete
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/go-chi/render"
+)
+
+const op = "http.DeleteProject"
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=ProjectRemover
+type ProjectRemover interface {
+	DeleteProject(ctx context.Context, id int64) error
+}
+
+// @Summary Delete a project
+// @Description Remove a project. Its tasks are kept without a project
+// @Produce json
+// @Param id query int true "Project ID"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid project ID"
+// @Failure 404 {object} Response "Project not found"
+// @Failure 500 {object} Response "Failed to delete project"
+// @Router /api/projects [delete]
+func New(log *slog.Logger, pr ProjectRemover) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		param := r.URL.Query().Get("id")
+		logger := log.With(slog.String("op", op), slog.String("id", param))
+
+		id, err := strconv.Atoi(param)
+		if err != nil {
+			logger.Error("gotten invalid id")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "gotten invalid id"})
+			return
+		}
+
+		err = pr.DeleteProject(r.Context(), int64(id))
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to delete project")})
+			return
+		}
+
+		logger.Info("project was deleted")
+		render.JSON(w, r, Response{})
+	}
+}
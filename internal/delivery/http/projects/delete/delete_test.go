@@ -0,0 +1,87 @@
+package delete_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/projects/delete"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/projects/delete/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteProjectHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		mockSetup  func(m *mocks.ProjectRemover)
+		wantStatus int
+		wantResp   delete.Response
+	}{
+		{
+			name: "successful deletion",
+			id:   "2",
+			mockSetup: func(m *mocks.ProjectRemover) {
+				m.On("DeleteProject", mock.Anything, int64(2)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   delete.Response{},
+		},
+		{
+			name:       "unsuccessful deletion - invalid id",
+			id:         "garden",
+			mockSetup:  func(m *mocks.ProjectRemover) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   delete.Response{Err: "gotten invalid id"},
+		},
+		{
+			name: "unsuccessful deletion - project not found",
+			id:   "2",
+			mockSetup: func(m *mocks.ProjectRemover) {
+				m.On("DeleteProject", mock.Anything, int64(2)).Return(tasks.ErrProjectNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   delete.Response{Err: "project not found"},
+		},
+		{
+			name: "unsuccessful deletion - database error",
+			id:   "2",
+			mockSetup: func(m *mocks.ProjectRemover) {
+				m.On("DeleteProject", mock.Anything, int64(2)).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   delete.Response{Err: "failed to delete project"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewProjectRemover(t)
+			tc.mockSetup(mock)
+
+			handler := delete.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/projects?id="+tc.id, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Delete(`/api/projects`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp delete.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
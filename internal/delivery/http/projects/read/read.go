@@ -0,0 +1,45 @@
+package read
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Projects"
+
+type Response struct {
+	Projects []models.Project `json:"projects,omitempty"`
+	Err      string           `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=ProjectReader
+type ProjectReader interface {
+	Projects(ctx context.Context) ([]models.Project, error)
+}
+
+// @Summary Get projects
+// @Description Retrieve all projects, active ones first, in alphabetical order
+// @Produce json
+// @Success 200 {object} Response
+// @Failure 500 {object} Response "Failed to read projects"
+// @Router /api/projects [get]
+func New(log *slog.Logger, pr ProjectReader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		projects, err := pr.Projects(r.Context())
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, Response{Err: "failed to read projects"})
+			return
+		}
+
+		logger.Info("projects were read")
+		render.JSON(w, r, Response{Projects: projects})
+	}
+}
@@ -0,0 +1,70 @@
+package read_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/projects/read"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/projects/read/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProjectsHandler(t *testing.T) {
+	projects := []models.Project{{ID: 2, Name: "Garden", Color: "#2e8b57"}, {ID: 1, Name: "Move", Archived: true}}
+
+	tests := []struct {
+		name       string
+		mockSetup  func(m *mocks.ProjectReader)
+		wantStatus int
+		wantResp   read.Response
+	}{
+		{
+			name: "successful projects reading",
+			mockSetup: func(m *mocks.ProjectReader) {
+				m.On("Projects", mock.Anything).Return(projects, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   read.Response{Projects: projects},
+		},
+		{
+			name: "unsuccessful projects reading - database error",
+			mockSetup: func(m *mocks.ProjectReader) {
+				m.On("Projects", mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   read.Response{Err: "failed to read projects"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewProjectReader(t)
+			tc.mockSetup(mock)
+
+			handler := read.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/projects", nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Get(`/api/projects`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp read.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package update
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const op = "http.UpdateProject"
+
+type Request struct {
+	ID       int64  `json:"id" validate:"required,min=1"`
+	Name     string `json:"name" validate:"required,max=64"`
+	Color    string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+	Archived bool   `json:"archived"`
+}
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=ProjectUpdater
+type ProjectUpdater interface {
+	UpdateProject(ctx context.Context, project models.Project) error
+}
+
+// @Summary Update a project
+// @Description Rename, recolour, archive or unarchive a project. Archived projects keep their tasks but take no new ones
+// @Accept json
+// @Produce json
+// @Param request body Request true "Project data"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 404 {object} Response "Project not found"
+// @Failure 500 {object} Response "Failed to update project"
+// @Router /api/projects [put]
+func New(log *slog.Logger, pu ProjectUpdater) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		var req Request
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			logger.Error("failed to decode request body")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "failed to decode request body"})
+			return
+		}
+
+		if err := validator.New().Struct(req); err != nil {
+			logger.Error("invalid request")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(err.(validator.ValidationErrors))})
+			return
+		}
+
+		err := pu.UpdateProject(r.Context(), models.Project{ID: req.ID, Name: req.Name, Color: req.Color, Archived: req.Archived})
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to update project")})
+			return
+		}
+
+		logger.Info("project was updated", slog.Int64("id", req.ID))
+		render.JSON(w, r, Response{})
+	}
+}
@@ -0,0 +1,92 @@
+package update_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/projects/update"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/projects/update/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateProjectHandler(t *testing.T) {
+	project := models.Project{ID: 2, Name: "Garden", Color: "#2e8b57", Archived: true}
+
+	tests := []struct {
+		name        string
+		requestBody string
+		mockSetup   func(m *mocks.ProjectUpdater)
+		wantStatus  int
+		wantResp    update.Response
+	}{
+		{
+			name:        "successful update",
+			requestBody: `{"id":2,"name":"Garden","color":"#2e8b57","archived":true}`,
+			mockSetup: func(m *mocks.ProjectUpdater) {
+				m.On("UpdateProject", mock.Anything, project).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   update.Response{},
+		},
+		{
+			name:        "unsuccessful update - missing id",
+			requestBody: `{"name":"Garden"}`,
+			mockSetup:   func(m *mocks.ProjectUpdater) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    update.Response{Err: "field ID is required"},
+		},
+		{
+			name:        "unsuccessful update - project not found",
+			requestBody: `{"id":2,"name":"Garden","color":"#2e8b57","archived":true}`,
+			mockSetup: func(m *mocks.ProjectUpdater) {
+				m.On("UpdateProject", mock.Anything, project).Return(tasks.ErrProjectNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   update.Response{Err: "project not found"},
+		},
+		{
+			name:        "unsuccessful update - database error",
+			requestBody: `{"id":2,"name":"Garden","color":"#2e8b57","archived":true}`,
+			mockSetup: func(m *mocks.ProjectUpdater) {
+				m.On("UpdateProject", mock.Anything, project).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   update.Response{Err: "failed to update project"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewProjectUpdater(t)
+			tc.mockSetup(mock)
+
+			handler := update.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/projects", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Put(`/api/projects`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp update.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package signup
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const op = "http.SignUp"
+
+type Request struct {
+	Name     string `json:"name" validate:"required,username"`
+	Password string `json:"password" validate:"required,password"`
+	Timezone string `json:"timezone,omitempty" validate:"omitempty,timezone"` // IANA time zone reminders are due in
+}
+
+type Response struct {
+	ID  int64  `json:"id,omitempty"`
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=UserRegistrar
+type UserRegistrar interface {
+	Register(ctx context.Context, name, password, timezone string, admin bool) (models.User, error)
+}
+
+// @Summary Sign up
+// @Description Create an account to keep tasks in. Accounts can only be created once the admin has been set up from PASSWORD
+// @Accept json
+// @Produce json
+// @Param request body Request true "Credentials"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 409 {object} Response "User name is already taken or there is no admin account yet"
+// @Failure 500 {object} Response "Failed to sign up"
+// @Router /api/signup [post]
+func New(log *slog.Logger, ur UserRegistrar) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		var req Request
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			logger.Error("failed to decode request body")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "failed to decode request body"})
+			return
+		}
+
+		v := validator.New()
+		v.RegisterValidation("username", validation.IsUserNameValid)
+		v.RegisterValidation("password", validation.IsPasswordValid)
+		if err := v.Struct(req); err != nil {
+			logger.Error("invalid request")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(err.(validator.ValidationErrors))})
+			return
+		}
+
+		user, err := ur.Register(r.Context(), req.Name, req.Password, req.Timezone, false)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to sign up")})
+			return
+		}
+
+		logger.Info("user signed up", slog.Int64("id", user.ID))
+		render.JSON(w, r, Response{ID: user.ID})
+	}
+}
@@ -0,0 +1,122 @@
+package signup_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/signup"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/signup/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/users"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSignUpHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestBody string
+		mockSetup   func(m *mocks.UserRegistrar)
+		wantStatus  int
+		wantResp    signup.Response
+	}{
+		{
+			name:        "successful sign-up",
+			requestBody: `{"name":"alice","password":"correct horse"}`,
+			mockSetup: func(m *mocks.UserRegistrar) {
+				m.On("Register", mock.Anything, "alice", "correct horse", "", false).Return(models.User{ID: 3, Name: "alice"}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   signup.Response{ID: 3},
+		},
+		{
+			name:        "successful sign-up - with time zone",
+			requestBody: `{"name":"alice","password":"correct horse","timezone":"Europe/Berlin"}`,
+			mockSetup: func(m *mocks.UserRegistrar) {
+				m.On("Register", mock.Anything, "alice", "correct horse", "Europe/Berlin", false).Return(models.User{ID: 3, Name: "alice"}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   signup.Response{ID: 3},
+		},
+		{
+			name:        "unsuccessful sign-up - unknown time zone",
+			requestBody: `{"name":"alice","password":"correct horse","timezone":"Mars/Olympus"}`,
+			mockSetup:   func(m *mocks.UserRegistrar) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    signup.Response{Err: "field Timezone must be an IANA time zone name"},
+		},
+		{
+			name:        "unsuccessful sign-up - blank name",
+			requestBody: `{"name":" alice ","password":"correct horse"}`,
+			mockSetup:   func(m *mocks.UserRegistrar) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    signup.Response{Err: "field Name must be a non-blank name of at most 64 characters without surrounding spaces"},
+		},
+		{
+			name:        "unsuccessful sign-up - short password",
+			requestBody: `{"name":"alice","password":"horse"}`,
+			mockSetup:   func(m *mocks.UserRegistrar) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    signup.Response{Err: "field Password must be 8 to 72 bytes long"},
+		},
+		{
+			name:        "unsuccessful sign-up - name taken",
+			requestBody: `{"name":"alice","password":"correct horse"}`,
+			mockSetup: func(m *mocks.UserRegistrar) {
+				m.On("Register", mock.Anything, "alice", "correct horse", "", false).Return(models.User{}, users.ErrNameTaken)
+			},
+			wantStatus: http.StatusConflict,
+			wantResp:   signup.Response{Err: "user name is already taken"},
+		},
+		{
+			name:        "unsuccessful sign-up - no admin yet",
+			requestBody: `{"name":"alice","password":"correct horse"}`,
+			mockSetup: func(m *mocks.UserRegistrar) {
+				m.On("Register", mock.Anything, "alice", "correct horse", "", false).Return(models.User{}, users.ErrNoAdmin)
+			},
+			wantStatus: http.StatusConflict,
+			wantResp:   signup.Response{Err: "there is no admin account yet, start the tracker with PASSWORD to create one"},
+		},
+		{
+			name:        "unsuccessful sign-up - database error",
+			requestBody: `{"name":"alice","password":"correct horse"}`,
+			mockSetup: func(m *mocks.UserRegistrar) {
+				m.On("Register", mock.Anything, "alice", "correct horse", "", false).Return(models.User{}, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   signup.Response{Err: "failed to sign up"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewUserRegistrar(t)
+			tc.mockSetup(mock)
+
+			handler := signup.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/signup", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/signup`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp signup.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
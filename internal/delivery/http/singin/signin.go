@@ -1,22 +1,23 @@
 package singin
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"log/slog"
 	"net/http"
-	"os"
 
 	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/10Narratives/task-tracker/internal/lib/token"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/users"
 	"github.com/go-chi/render"
 	"github.com/go-playground/validator/v10"
-	"github.com/golang-jwt/jwt/v5"
 )
 
-// TODO: Make sign in handlers
-
 const op = "http.Authentication"
 
 type Request struct {
+	Name     string `json:"name,omitempty"`
 	Password string `json:"password" validate:"required"`
 }
 
@@ -25,52 +26,68 @@ type Response struct {
 	Err   string `json:"error,omitempty"`
 }
 
-func New(log *slog.Logger) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		log := log.With(slog.String("op", op))
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=Authenticator
+type Authenticator interface {
+	SignIn(ctx context.Context, name, password string) (models.User, error)
+}
 
-		//authHeader := r.Header.Get("Authorization")
-		//if authHeader == "" {
-		//	log.Error("unauthorized")
-		//	w.WriteHeader(http.StatusUnauthorized)
-		//	render.JSON(w, r, Response{Err: "unauthorized"})
-		//	return
-		//}
+// @Summary Sign in
+// @Description Check the name and password of an account and issue a token to authenticate its requests with,
+// @Description either in the token cookie or as a bearer token. Sign-ins without a name are made with the owner account
+// @Accept json
+// @Produce json
+// @Param request body Request true "Credentials"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 401 {object} Response "Wrong user name or password"
+// @Failure 500 {object} Response "Failed to sign in"
+// @Router /api/signin [post]
+func New(log *slog.Logger, auth Authenticator, tokens token.Issuer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
 
 		var req Request
-		fmt.Println("!!!")
-		err := render.DecodeJSON(r.Body, &req)
-		if err != nil {
-			log.Error("failed to decode request body")
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			logger.Error("failed to decode request body")
 			w.WriteHeader(http.StatusBadRequest)
 			render.JSON(w, r, Response{Err: "failed to decode request body"})
 			return
 		}
-		fmt.Println("!!!")
-		log.Info("request body decoded", slog.Any("request", req))
-
-		v := validator.New()
-		if err := v.Struct(req); err != nil {
-			validationErr := err.(validator.ValidationErrors)
 
-			log.Error("invalid request")
+		if err := validator.New().Struct(req); err != nil {
+			logger.Error("invalid request")
 			w.WriteHeader(http.StatusBadRequest)
-			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(validationErr)})
+			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(err.(validator.ValidationErrors))})
+			return
+		}
+		if req.Name == "" {
+			req.Name = users.BootstrapName
+		}
+		logger = logger.With(slog.String("name", req.Name))
 
+		user, err := auth.SignIn(r.Context(), req.Name, req.Password)
+		switch {
+		case errors.Is(err, users.ErrWrongCredentials):
+			logger.Error(err.Error())
+			w.WriteHeader(http.StatusUnauthorized)
+			render.JSON(w, r, Response{Err: err.Error()})
+			return
+		case err != nil:
+			logger.Error(err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, Response{Err: "failed to sign in"})
 			return
 		}
 
-		// TODO: make JWT token
-		pass := []byte(os.Getenv("PASSWORD"))
-		token := jwt.New(jwt.SigningMethodHS256)
-		signedToken, err := token.SignedString(pass)
+		signedToken, err := tokens.Issue(user.ID)
 		if err != nil {
-			log.Error("can not sign jwt token " + err.Error())
+			logger.Error("can not sign jwt token " + err.Error())
 			w.WriteHeader(http.StatusInternalServerError)
 			render.JSON(w, r, Response{Err: "can not sign jwt token"})
 			return
 		}
 
+		logger.Info("user signed in", slog.Int64("id", user.ID))
 		render.JSON(w, r, Response{Token: signedToken})
 	}
 }
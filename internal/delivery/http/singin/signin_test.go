@@ -0,0 +1,110 @@
+package singin_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/singin"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/singin/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/lib/token"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/users"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignInHandler(t *testing.T) {
+	tokens := token.New([]byte("secret"), time.Hour)
+
+	tests := []struct {
+		name        string
+		requestBody string
+		mockSetup   func(m *mocks.Authenticator)
+		wantStatus  int
+		wantUserID  int64
+		wantErr     string
+	}{
+		{
+			name:        "successful sign-in",
+			requestBody: `{"name":"alice","password":"correct horse"}`,
+			mockSetup: func(m *mocks.Authenticator) {
+				m.On("SignIn", mock.Anything, "alice", "correct horse").Return(models.User{ID: 3, Name: "alice"}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantUserID: 3,
+		},
+		{
+			name:        "sign-in without a name",
+			requestBody: `{"password":"correct horse"}`,
+			mockSetup: func(m *mocks.Authenticator) {
+				m.On("SignIn", mock.Anything, users.BootstrapName, "correct horse").Return(models.User{ID: 1, Name: users.BootstrapName, Admin: true}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantUserID: 1,
+		},
+		{
+			name:        "unsuccessful sign-in - missing password",
+			requestBody: `{"name":"alice"}`,
+			mockSetup:   func(m *mocks.Authenticator) {},
+			wantStatus:  http.StatusBadRequest,
+			wantErr:     "field Password is required",
+		},
+		{
+			name:        "unsuccessful sign-in - wrong credentials",
+			requestBody: `{"name":"alice","password":"wrong"}`,
+			mockSetup: func(m *mocks.Authenticator) {
+				m.On("SignIn", mock.Anything, "alice", "wrong").Return(models.User{}, users.ErrWrongCredentials)
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantErr:    "wrong user name or password",
+		},
+		{
+			name:        "unsuccessful sign-in - database error",
+			requestBody: `{"name":"alice","password":"correct horse"}`,
+			mockSetup: func(m *mocks.Authenticator) {
+				m.On("SignIn", mock.Anything, "alice", "correct horse").Return(models.User{}, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantErr:    "failed to sign in",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewAuthenticator(t)
+			tc.mockSetup(mock)
+
+			handler := singin.New(slogdiscard.NewDiscardLogger(), mock, tokens)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/signin", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/signin`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp singin.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantErr, actualResp.Err)
+			if tc.wantUserID != 0 {
+				userID, err := tokens.Parse(actualResp.Token)
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantUserID, userID)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Package status maps the kinds of domain errors to the HTTP statuses and messages answering them,
+// so that every handler reports a missing record or a broken rule the same way.
+package status
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// Of returns the HTTP status answering err: 404 for domain.ErrNotFound, 409 for domain.ErrConflict,
+// 400 for domain.ErrInvalidRule, 412 for domain.ErrPrecondition and 500 for any other error.
+func Of(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrInvalidRule):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrPrecondition):
+		return http.StatusPreconditionFailed
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Message returns the message of a domain error, which is meant for the client,
+// or fallback for any other error, whose details are only logged.
+func Message(err error, fallback string) string {
+	if Of(err) == http.StatusInternalServerError {
+		return fallback
+	}
+	return err.Error()
+}
@@ -0,0 +1,65 @@
+package status_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantMessage string
+	}{
+		{
+			name:        "not found",
+			err:         fmt.Errorf("cannot read: %w", domain.New(domain.ErrNotFound, "task 7 not found")),
+			wantStatus:  http.StatusNotFound,
+			wantMessage: "cannot read: task 7 not found",
+		},
+		{
+			name:        "conflict",
+			err:         domain.New(domain.ErrConflict, "tag already exists"),
+			wantStatus:  http.StatusConflict,
+			wantMessage: "tag already exists",
+		},
+		{
+			name:        "invalid rule",
+			err:         domain.New(domain.ErrInvalidRule, "invalid repeat rule"),
+			wantStatus:  http.StatusBadRequest,
+			wantMessage: "invalid repeat rule",
+		},
+		{
+			name:        "precondition",
+			err:         domain.New(domain.ErrPrecondition, "task was changed in the meantime"),
+			wantStatus:  http.StatusPreconditionFailed,
+			wantMessage: "task was changed in the meantime",
+		},
+		{
+			name:        "other error",
+			err:         errors.New("database error"),
+			wantStatus:  http.StatusInternalServerError,
+			wantMessage: "failed to read tasks",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.wantStatus, status.Of(tc.err))
+			assert.Equal(t, tc.wantMessage, status.Message(tc.err, "failed to read tasks"))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package adddependency
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/go-chi/render"
+)
+
+const op = "http.AddDependency"
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=DependencyAdder
+type DependencyAdder interface {
+	AddDependency(ctx context.Context, id, blockerID int64) error
+}
+
+// @Summary Make a task wait for another one
+// @Description Block a task until the blocker is done. Dependencies which would make a task wait for itself are refused
+// @Produce json
+// @Param id query int true "ID of the blocked task"
+// @Param blocker_id query int true "ID of the blocking task"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid task or blocker ID"
+// @Failure 404 {object} Response "Task not found"
+// @Failure 409 {object} Response "Dependency would create a cycle"
+// @Failure 500 {object} Response "Failed to add dependency"
+// @Router /api/task/dependency [post]
+func New(log *slog.Logger, da DependencyAdder) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idParam, blockerParam := r.URL.Query().Get("id"), r.URL.Query().Get("blocker_id")
+		logger := log.With(slog.String("op", op), slog.String("id", idParam), slog.String("blocker_id", blockerParam))
+
+		id, err := strconv.Atoi(idParam)
+		if err != nil {
+			logger.Error("gotten invalid id")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "gotten invalid id"})
+			return
+		}
+
+		blockerID, err := strconv.Atoi(blockerParam)
+		if err != nil {
+			logger.Error("gotten invalid blocker id")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "gotten invalid blocker id"})
+			return
+		}
+
+		err = da.AddDependency(r.Context(), int64(id), int64(blockerID))
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to add dependency")})
+			return
+		}
+
+		logger.Info("dependency was added")
+		render.JSON(w, r, Response{})
+	}
+}
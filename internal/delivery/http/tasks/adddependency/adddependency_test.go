@@ -0,0 +1,104 @@
+package adddependency_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/adddependency"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/adddependency/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAddDependencyHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(m *mocks.DependencyAdder)
+		wantStatus int
+		wantResp   adddependency.Response
+	}{
+		{
+			name:  "successful addition",
+			query: "id=100&blocker_id=4",
+			mockSetup: func(m *mocks.DependencyAdder) {
+				m.On("AddDependency", mock.Anything, int64(100), int64(4)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   adddependency.Response{},
+		},
+		{
+			name:       "unsuccessful addition - invalid id",
+			query:      "id=task&blocker_id=4",
+			mockSetup:  func(m *mocks.DependencyAdder) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   adddependency.Response{Err: "gotten invalid id"},
+		},
+		{
+			name:       "unsuccessful addition - missing blocker id",
+			query:      "id=100",
+			mockSetup:  func(m *mocks.DependencyAdder) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   adddependency.Response{Err: "gotten invalid blocker id"},
+		},
+		{
+			name:  "unsuccessful addition - blocker not found",
+			query: "id=100&blocker_id=4",
+			mockSetup: func(m *mocks.DependencyAdder) {
+				m.On("AddDependency", mock.Anything, int64(100), int64(4)).Return(fmt.Errorf("%w: %d", tasks.ErrTaskNotFound, 4))
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   adddependency.Response{Err: "task not found: 4"},
+		},
+		{
+			name:  "unsuccessful addition - cycle",
+			query: "id=100&blocker_id=4",
+			mockSetup: func(m *mocks.DependencyAdder) {
+				m.On("AddDependency", mock.Anything, int64(100), int64(4)).Return(tasks.ErrDependencyCycle)
+			},
+			wantStatus: http.StatusConflict,
+			wantResp:   adddependency.Response{Err: "dependency would create a cycle"},
+		},
+		{
+			name:  "unsuccessful addition - database error",
+			query: "id=100&blocker_id=4",
+			mockSetup: func(m *mocks.DependencyAdder) {
+				m.On("AddDependency", mock.Anything, int64(100), int64(4)).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   adddependency.Response{Err: "failed to add dependency"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewDependencyAdder(t)
+			tc.mockSetup(mock)
+
+			handler := adddependency.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/task/dependency?"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/task/dependency`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp adddependency.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const op = "http.Batch"
+
+// Task holds the new details of a task for an update operation.
+type Task struct {
+	Date      string   `json:"date" validate:"required,dateformat"`
+	Time      *string  `json:"time,omitempty" validate:"omitempty,timeofday"`
+	Title     string   `json:"title" validate:"required,title"`
+	Comment   string   `json:"comment"`
+	Repeat    string   `json:"repeat" validate:"repeat"`
+	Anchor    string   `json:"anchor,omitempty" validate:"omitempty,oneof=due completion"`
+	ExDates   []string `json:"exdates,omitempty" validate:"dive,dateformat"`
+	Priority  int      `json:"priority,omitempty" validate:"omitempty,min=1,max=4"`
+	Status    string   `json:"status,omitempty" validate:"omitempty,oneof=todo in_progress blocked done"`
+	ProjectID int64    `json:"project_id,omitempty" validate:"omitempty,min=1"`
+	Reminders []int    `json:"reminders,omitempty" validate:"max=10,unique,dive,min=0,max=40320"`
+	Tags      []string `json:"tags" validate:"max=20,dive,tag"`
+}
+
+// Operation is a single action of a batch. Updates carry the new details of the task,
+// date moves the new date and completions may be forced past open blockers. A version
+// makes the operation fail when the task was changed since that version was read.
+type Operation struct {
+	Action  string `json:"action" validate:"required,oneof=complete delete update move_date"`
+	ID      int64  `json:"id" validate:"required,min=1"`
+	Task    *Task  `json:"task,omitempty" validate:"required_if=Action update"`
+	Date    string `json:"date,omitempty" validate:"required_if=Action move_date,omitempty,dateformat"`
+	Force   bool   `json:"force,omitempty"`
+	Version int64  `json:"version,omitempty" validate:"min=0"`
+}
+
+type Request struct {
+	Operations []Operation `json:"operations" validate:"required,min=1,max=100,dive"`
+}
+
+type Response struct {
+	Results []models.BatchResult `json:"results,omitempty"`
+	Err     string               `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=BatchApplier
+type BatchApplier interface {
+	Batch(ctx context.Context, ops []models.BatchOperation) ([]models.BatchResult, error)
+}
+
+// @Summary Apply operations to several tasks at once
+// @Description Complete, delete, update or move tasks to another date in a single transaction.
+// @Description Either all operations are applied or, if any of them fails, none. The result of every operation is reported
+// @Accept json
+// @Produce json
+// @Param request body Request true "Operations in the order they are applied"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid request format or operations"
+// @Failure 409 {object} Response "Some operations failed and the batch was rolled back"
+// @Failure 500 {object} Response "Failed to apply batch"
+// @Router /api/tasks/batch [post]
+func New(log *slog.Logger, ba BatchApplier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		var req Request
+		err := render.DecodeJSON(r.Body, &req)
+		if errors.Is(err, io.EOF) {
+			logger.Error("request body is empty")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "empty request"})
+			return
+		}
+		if err != nil {
+			logger.Error("failed to decode request body")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "failed to decode request body"})
+			return
+		}
+
+		for i, operation := range req.Operations {
+			if operation.Task == nil {
+				continue
+			}
+			if operation.Task.Repeat, err = validation.NormalizeRepeat(operation.Task.Repeat); err != nil {
+				logger.Error("invalid repeat rule", slog.String("error", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, Response{Err: fmt.Sprintf("operation %d: %s", i, err.Error())})
+				return
+			}
+		}
+
+		v := validator.New()
+		v.RegisterValidation("dateformat", validation.IsDateValid)
+		v.RegisterValidation("timeofday", validation.IsTimeValid)
+		v.RegisterValidation("title", validation.IsTitleValid)
+		v.RegisterValidation("repeat", validation.IsRepeatValid)
+		v.RegisterValidation("tag", validation.IsTagValid)
+		if err := v.Struct(req); err != nil {
+			validationErr := err.(validator.ValidationErrors)
+			logger.Error("invalid request")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(validationErr)})
+			return
+		}
+
+		ops := make([]models.BatchOperation, 0, len(req.Operations))
+		for _, operation := range req.Operations {
+			batchOp := models.BatchOperation{Action: operation.Action, ID: operation.ID, Date: operation.Date, Force: operation.Force, Version: operation.Version}
+			if t := operation.Task; t != nil {
+				// A time left out is kept, an empty one drops the time of day.
+				var clock string
+				if t.Time != nil {
+					clock = *t.Time
+				}
+				batchOp.Task = models.Task{
+					Date:      t.Date,
+					Time:      clock,
+					ClearTime: t.Time != nil && *t.Time == "",
+					Title:     t.Title,
+					Comment:   t.Comment,
+					Repeat:    t.Repeat,
+					Anchor:    t.Anchor,
+					ExDates:   t.ExDates,
+					Priority:  t.Priority,
+					Status:    t.Status,
+					ProjectID: t.ProjectID,
+					Reminders: t.Reminders,
+					Tags:      t.Tags,
+				}
+			}
+			ops = append(ops, batchOp)
+		}
+
+		results, err := ba.Batch(r.Context(), ops)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Results: results, Err: status.Message(err, "failed to apply batch")})
+			return
+		}
+
+		logger.Info("batch was applied", slog.Int("operations", len(ops)))
+		render.JSON(w, r, Response{Results: results})
+	}
+}
@@ -0,0 +1,140 @@
+package batch_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/batch"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/batch/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBatchHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockSetup      func(m *mocks.BatchApplier)
+		expectedStatus int
+		expectedResp   batch.Response
+	}{
+		{
+			name: "batch is applied",
+			requestBody: `{"operations":[
+				{"action":"complete","id":4,"force":true},
+				{"action":"delete","id":5,"version":2},
+				{"action":"update","id":6,"task":{"date":"20250210","title":"Laundry","repeat":"FREQ=DAILY"}},
+				{"action":"move_date","id":7,"date":"20250211"}
+			]}`,
+			mockSetup: func(m *mocks.BatchApplier) {
+				m.On("Batch", mock.Anything, []models.BatchOperation{
+					{Action: "complete", ID: 4, Force: true},
+					{Action: "delete", ID: 5, Version: 2},
+					{Action: "update", ID: 6, Task: models.Task{Date: "20250210", Title: "Laundry", Repeat: "d 1"}},
+					{Action: "move_date", ID: 7, Date: "20250211"},
+				}).Return([]models.BatchResult{
+					{ID: 4, Action: "complete"},
+					{ID: 5, Action: "delete"},
+					{ID: 6, Action: "update"},
+					{ID: 7, Action: "move_date"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: batch.Response{Results: []models.BatchResult{
+				{ID: 4, Action: "complete"},
+				{ID: 5, Action: "delete"},
+				{ID: 6, Action: "update"},
+				{ID: 7, Action: "move_date"},
+			}},
+		},
+		{
+			name:           "empty request body",
+			requestBody:    ``,
+			mockSetup:      func(m *mocks.BatchApplier) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   batch.Response{Err: "empty request"},
+		},
+		{
+			name:           "no operations",
+			requestBody:    `{"operations":[]}`,
+			mockSetup:      func(m *mocks.BatchApplier) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   batch.Response{Err: "field Operations must be at least 1"},
+		},
+		{
+			name:           "unknown action",
+			requestBody:    `{"operations":[{"action":"archive","id":4}]}`,
+			mockSetup:      func(m *mocks.BatchApplier) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   batch.Response{Err: "field Action must be one of: complete, delete, update, move_date"},
+		},
+		{
+			name:           "date move without a date",
+			requestBody:    `{"operations":[{"action":"move_date","id":4}]}`,
+			mockSetup:      func(m *mocks.BatchApplier) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   batch.Response{Err: "field Date is required"},
+		},
+		{
+			name:           "update with invalid details",
+			requestBody:    `{"operations":[{"action":"update","id":4,"task":{"date":"2025-02-10","title":"Laundry"}}]}`,
+			mockSetup:      func(m *mocks.BatchApplier) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   batch.Response{Err: "field Date must be in YYYYMMDD date format"},
+		},
+		{
+			name:        "batch is rolled back",
+			requestBody: `{"operations":[{"action":"delete","id":4},{"action":"complete","id":9}]}`,
+			mockSetup: func(m *mocks.BatchApplier) {
+				m.On("Batch", mock.Anything, []models.BatchOperation{{Action: "delete", ID: 4}, {Action: "complete", ID: 9}}).
+					Return([]models.BatchResult{{ID: 4, Action: "delete"}, {ID: 9, Action: "complete", Err: "task not found"}},
+						fmt.Errorf("%w: 1 of 2 operations failed", tasks.ErrBatchFailed))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResp: batch.Response{
+				Results: []models.BatchResult{{ID: 4, Action: "delete"}, {ID: 9, Action: "complete", Err: "task not found"}},
+				Err:     "batch was rolled back: 1 of 2 operations failed",
+			},
+		},
+		{
+			name:        "batch fails",
+			requestBody: `{"operations":[{"action":"delete","id":4}]}`,
+			mockSetup: func(m *mocks.BatchApplier) {
+				m.On("Batch", mock.Anything, []models.BatchOperation{{Action: "delete", ID: 4}}).
+					Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResp:   batch.Response{Err: "failed to apply batch"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			applier := mocks.NewBatchApplier(t)
+			tc.mockSetup(applier)
+
+			handler := batch.New(slogdiscard.NewDiscardLogger(), applier)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/tasks/batch", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			var actualResp batch.Response
+			_ = json.Unmarshal(recorder.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.expectedResp, actualResp)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/10Narratives/task-tracker/internal/delivery/http/etag"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
 	"github.com/go-chi/render"
 )
 
@@ -17,15 +19,21 @@ type Response struct {
 
 //go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TaskCompleter
 type TaskCompleter interface {
-	Complete(ctx context.Context, id int64) error
+	Complete(ctx context.Context, id, version int64, force bool) error
 }
 
 // @Summary Complete task by its ID
-// @Description Mark task as completed, either by deleting it or modifying its deadline
+// @Description Mark task as completed, either by deleting it or modifying its deadline.
+// @Description Tasks with open blockers are only completed when force is set
 // @Produce json
 // @Param id query int true "Task ID"
+// @Param force query bool false "Complete the task even if its blockers are still open"
+// @Param If-Match header string false "ETag of the task version the completion is based on"
 // @Success 200 {object} Response
-// @Failure 400 {object} Response "Invalid task ID"
+// @Failure 400 {object} Response "Invalid task ID, force flag, repeat rule or If-Match header"
+// @Failure 404 {object} Response "Task not found"
+// @Failure 409 {object} Response "Task is blocked by open tasks"
+// @Failure 412 {object} Response "Task was changed since the given version"
 // @Failure 500 {object} Response "Failed to complete task"
 // @Router /api/task/done [post]
 func New(log *slog.Logger, tc TaskCompleter) http.HandlerFunc {
@@ -41,11 +49,29 @@ func New(log *slog.Logger, tc TaskCompleter) http.HandlerFunc {
 			return
 		}
 
-		err = tc.Complete(context.Background(), int64(id))
+		var force bool
+		if param := r.URL.Query().Get("force"); param != "" {
+			if force, err = strconv.ParseBool(param); err != nil {
+				logger.Error("gotten invalid force flag")
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, Response{Err: "field force must be true or false"})
+				return
+			}
+		}
+
+		version, err := etag.Parse(r.Header.Get("If-Match"))
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: err.Error()})
+			return
+		}
+
+		err = tc.Complete(r.Context(), int64(id), version, force)
 		if err != nil {
-			logger.Error("failed to complete task")
-			w.WriteHeader(http.StatusInternalServerError)
-			render.JSON(w, r, Response{Err: "failed to complete task"})
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to complete task")})
 			return
 		}
 
@@ -3,6 +3,7 @@ package complete_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/complete"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/complete/mocks"
 	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
 	"github.com/go-chi/chi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -20,18 +22,77 @@ func TestCompleteHandler(t *testing.T) {
 		name       string
 		mockSetup  func(m *mocks.TaskCompleter)
 		id         string
+		force      string
+		ifMatch    string
 		wantStatus int
 		wantResp   complete.Response
 	}{
 		{
 			name: "successful complete",
 			mockSetup: func(m *mocks.TaskCompleter) {
-				m.On("Complete", mock.Anything, int64(100)).Return(nil)
+				m.On("Complete", mock.Anything, int64(100), int64(0), false).Return(nil)
 			},
 			id:         "100",
 			wantStatus: http.StatusOK,
 			wantResp:   complete.Response{},
 		},
+		{
+			name: "successful complete - forced",
+			mockSetup: func(m *mocks.TaskCompleter) {
+				m.On("Complete", mock.Anything, int64(100), int64(0), true).Return(nil)
+			},
+			id:         "100",
+			force:      "true",
+			wantStatus: http.StatusOK,
+			wantResp:   complete.Response{},
+		},
+		{
+			name: "successful complete - matching version",
+			mockSetup: func(m *mocks.TaskCompleter) {
+				m.On("Complete", mock.Anything, int64(100), int64(2), false).Return(nil)
+			},
+			id:         "100",
+			ifMatch:    `"2"`,
+			wantStatus: http.StatusOK,
+			wantResp:   complete.Response{},
+		},
+		{
+			name: "unsuccessful complete - task has changed",
+			mockSetup: func(m *mocks.TaskCompleter) {
+				m.On("Complete", mock.Anything, int64(100), int64(2), false).Return(fmt.Errorf("%w: version 2 is not the current version 5", tasks.ErrVersionMismatch))
+			},
+			id:         "100",
+			ifMatch:    `"2"`,
+			wantStatus: http.StatusPreconditionFailed,
+			wantResp:   complete.Response{Err: "task was changed in the meantime: version 2 is not the current version 5"},
+		},
+		{
+			name: "unsuccessful complete - invalid If-Match",
+			mockSetup: func(m *mocks.TaskCompleter) {
+			},
+			id:         "100",
+			ifMatch:    `W/"2"`,
+			wantStatus: http.StatusBadRequest,
+			wantResp:   complete.Response{Err: "If-Match header must hold the ETag of a task"},
+		},
+		{
+			name: "unsuccessful complete - blocked task",
+			mockSetup: func(m *mocks.TaskCompleter) {
+				m.On("Complete", mock.Anything, int64(100), int64(0), false).Return(fmt.Errorf("%w: %v", tasks.ErrBlocked, []int64{4}))
+			},
+			id:         "100",
+			wantStatus: http.StatusConflict,
+			wantResp:   complete.Response{Err: "task is blocked by open tasks: [4]"},
+		},
+		{
+			name: "unsuccessful complete - invalid force flag",
+			mockSetup: func(m *mocks.TaskCompleter) {
+			},
+			id:         "100",
+			force:      "maybe",
+			wantStatus: http.StatusBadRequest,
+			wantResp:   complete.Response{Err: "field force must be true or false"},
+		},
 		{
 			name: "unsuccessful complete - invalid id",
 			mockSetup: func(m *mocks.TaskCompleter) {
@@ -48,10 +109,19 @@ func TestCompleteHandler(t *testing.T) {
 			wantStatus: http.StatusBadRequest,
 			wantResp:   complete.Response{Err: "gotten invalid id"},
 		},
+		{
+			name: "unsuccessful complete - task not found",
+			mockSetup: func(m *mocks.TaskCompleter) {
+				m.On("Complete", mock.Anything, int64(100), int64(0), false).Return(fmt.Errorf("%w: 100", tasks.ErrTaskNotFound))
+			},
+			id:         "100",
+			wantStatus: http.StatusNotFound,
+			wantResp:   complete.Response{Err: "task not found: 100"},
+		},
 		{
 			name: "unsuccessful complete - database error",
 			mockSetup: func(m *mocks.TaskCompleter) {
-				m.On("Complete", mock.Anything, int64(100)).Return(errors.New("database error"))
+				m.On("Complete", mock.Anything, int64(100), int64(0), false).Return(errors.New("database error"))
 			},
 			id:         "100",
 			wantStatus: http.StatusInternalServerError,
@@ -74,8 +144,14 @@ func TestCompleteHandler(t *testing.T) {
 			if tc.id != "" {
 				url += "?id=" + tc.id
 			}
+			if tc.force != "" {
+				url += "&force=" + tc.force
+			}
 
 			req := httptest.NewRequest(http.MethodPost, url, nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
 			rec := httptest.NewRecorder()
 			r := chi.NewRouter()
 			r.Post(`/api/tasks/done`, handler)
@@ -13,17 +13,17 @@ type TaskCompleter struct {
 	mock.Mock
 }
 
-// Complete provides a mock function with given fields: ctx, id
-func (_m *TaskCompleter) Complete(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// Complete provides a mock function with given fields: ctx, id, version, force
+func (_m *TaskCompleter) Complete(ctx context.Context, id int64, version int64, force bool) error {
+	ret := _m.Called(ctx, id, version, force)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Complete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, bool) error); ok {
+		r0 = rf(ctx, id, version, force)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,64 @@
+package completions
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/lib"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Completions"
+
+type Response struct {
+	Completions []models.Completion `json:"completions,omitempty"`
+	Err         string              `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=CompletionReader
+type CompletionReader interface {
+	Completions(ctx context.Context, from, to string) ([]models.Completion, error)
+}
+
+// @Summary Get completions of all tasks
+// @Description Retrieve the completions made between two dates inclusive, oldest first
+// @Produce json
+// @Param from query string false "First day in YYYYMMDD format"
+// @Param to query string false "Last day in YYYYMMDD format"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid date"
+// @Failure 500 {object} Response "Failed to read completions"
+// @Router /api/completions [get]
+func New(log *slog.Logger, cr CompletionReader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		from, to := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+		logger := log.With(slog.String("op", op), slog.String("from", from), slog.String("to", to))
+
+		for _, param := range []struct{ name, value string }{{"from", from}, {"to", to}} {
+			if param.value == "" {
+				continue
+			}
+			if _, err := time.Parse(lib.DateFormat, param.value); err != nil {
+				logger.Error("invalid date")
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, Response{Err: "field " + param.name + " must be in YYYYMMDD date format"})
+				return
+			}
+		}
+
+		completions, err := cr.Completions(r.Context(), from, to)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to read completions")})
+			return
+		}
+
+		logger.Info("completions were read")
+		render.JSON(w, r, Response{Completions: completions})
+	}
+}
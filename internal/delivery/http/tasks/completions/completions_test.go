@@ -0,0 +1,102 @@
+package completions_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/completions"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/completions/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCompletionsHandler(t *testing.T) {
+	done := []models.Completion{
+		{ID: 1, TaskID: 100, Title: "Task", Date: "20250403", CompletedAt: "2025-04-03T09:00:00Z"},
+		{ID: 2, TaskID: 101, Title: "Other task", Date: "20250404", CompletedAt: "2025-04-04T10:00:00Z"},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(m *mocks.CompletionReader)
+		wantStatus int
+		wantResp   completions.Response
+	}{
+		{
+			name:  "successful reading - date range",
+			query: "?from=20250401&to=20250407",
+			mockSetup: func(m *mocks.CompletionReader) {
+				m.On("Completions", mock.Anything, "20250401", "20250407").Return(done, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   completions.Response{Completions: done},
+		},
+		{
+			name:  "successful reading - open range",
+			query: "",
+			mockSetup: func(m *mocks.CompletionReader) {
+				m.On("Completions", mock.Anything, "", "").Return(done, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   completions.Response{Completions: done},
+		},
+		{
+			name:  "unsuccessful reading - invalid from date",
+			query: "?from=2025-04-01",
+			mockSetup: func(m *mocks.CompletionReader) {
+			},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   completions.Response{Err: "field from must be in YYYYMMDD date format"},
+		},
+		{
+			name:  "unsuccessful reading - invalid to date",
+			query: "?from=20250401&to=tomorrow",
+			mockSetup: func(m *mocks.CompletionReader) {
+			},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   completions.Response{Err: "field to must be in YYYYMMDD date format"},
+		},
+		{
+			name:  "unsuccessful reading - database error",
+			query: "?from=20250401",
+			mockSetup: func(m *mocks.CompletionReader) {
+				m.On("Completions", mock.Anything, "20250401", "").Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   completions.Response{Err: "failed to read completions"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewCompletionReader(t)
+			tc.mockSetup(mock)
+
+			handler := completions.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/completions"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Get(`/api/completions`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp completions.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+			mock.AssertExpectations(t)
+		})
+	}
+}
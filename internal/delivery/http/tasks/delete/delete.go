@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/10Narratives/task-tracker/internal/delivery/http/etag"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
 	"github.com/go-chi/render"
 )
 
@@ -17,15 +19,18 @@ type Response struct {
 
 //go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TaskRemover
 type TaskRemover interface {
-	Delete(ctx context.Context, id int64) error
+	Delete(ctx context.Context, id, version int64) error
 }
 
 // @Summary Delete task by its ID
 // @Description Permanently remove a task from the system
 // @Produce json
 // @Param id query int true "Task ID"
+// @Param If-Match header string false "ETag of the task version the deletion is based on"
 // @Success 200 {object} Response
-// @Failure 400 {object} Response "Invalid task ID"
+// @Failure 400 {object} Response "Invalid task ID or If-Match header"
+// @Failure 404 {object} Response "Task not found"
+// @Failure 412 {object} Response "Task was changed since the given version"
 // @Failure 500 {object} Response "Failed to delete task"
 // @Router /api/task [delete]
 func New(logger *slog.Logger, tr TaskRemover) http.HandlerFunc {
@@ -40,11 +45,19 @@ func New(logger *slog.Logger, tr TaskRemover) http.HandlerFunc {
 			render.JSON(w, r, Response{Err: "gotten invalid id"})
 			return
 		}
-		err = tr.Delete(context.Background(), int64(id))
+		version, err := etag.Parse(r.Header.Get("If-Match"))
 		if err != nil {
-			logger.Error("failed to delete task")
-			w.WriteHeader(http.StatusInternalServerError)
-			render.JSON(w, r, Response{Err: "failed to delete task"})
+			logger.Error(err.Error())
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: err.Error()})
+			return
+		}
+
+		err = tr.Delete(r.Context(), int64(id), version)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to delete task")})
 			return
 		}
 		logger.Info("task was deleted")
@@ -3,6 +3,7 @@ package delete_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/delete"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/delete/mocks"
 	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
 	"github.com/go-chi/chi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -20,18 +22,48 @@ func TestDeleteHandler(t *testing.T) {
 		name       string
 		mockSetup  func(m *mocks.TaskRemover)
 		id         string
+		ifMatch    string
 		wantStatus int
 		wantResp   delete.Response
 	}{
 		{
 			name: "successful deletion",
 			mockSetup: func(m *mocks.TaskRemover) {
-				m.On("Delete", mock.Anything, int64(100)).Return(nil)
+				m.On("Delete", mock.Anything, int64(100), int64(0)).Return(nil)
 			},
 			id:         "100",
 			wantStatus: http.StatusOK,
 			wantResp:   delete.Response{},
 		},
+		{
+			name: "successful deletion - matching version",
+			mockSetup: func(m *mocks.TaskRemover) {
+				m.On("Delete", mock.Anything, int64(100), int64(3)).Return(nil)
+			},
+			id:         "100",
+			ifMatch:    `"3"`,
+			wantStatus: http.StatusOK,
+			wantResp:   delete.Response{},
+		},
+		{
+			name: "unsuccessful deletion - task has changed",
+			mockSetup: func(m *mocks.TaskRemover) {
+				m.On("Delete", mock.Anything, int64(100), int64(3)).Return(fmt.Errorf("%w: version 3 is not the current version 4", tasks.ErrVersionMismatch))
+			},
+			id:         "100",
+			ifMatch:    `"3"`,
+			wantStatus: http.StatusPreconditionFailed,
+			wantResp:   delete.Response{Err: "task was changed in the meantime: version 3 is not the current version 4"},
+		},
+		{
+			name: "unsuccessful deletion - invalid If-Match",
+			mockSetup: func(m *mocks.TaskRemover) {
+			},
+			id:         "100",
+			ifMatch:    "3",
+			wantStatus: http.StatusBadRequest,
+			wantResp:   delete.Response{Err: "If-Match header must hold the ETag of a task"},
+		},
 		{
 			name: "unsuccessful deletion - invalid id",
 			mockSetup: func(m *mocks.TaskRemover) {
@@ -48,10 +80,19 @@ func TestDeleteHandler(t *testing.T) {
 			wantStatus: http.StatusBadRequest,
 			wantResp:   delete.Response{Err: "gotten invalid id"},
 		},
+		{
+			name: "unsuccessful deletion - task not found",
+			mockSetup: func(m *mocks.TaskRemover) {
+				m.On("Delete", mock.Anything, int64(100), int64(0)).Return(fmt.Errorf("%w: 100", tasks.ErrTaskNotFound))
+			},
+			id:         "100",
+			wantStatus: http.StatusNotFound,
+			wantResp:   delete.Response{Err: "task not found: 100"},
+		},
 		{
 			name: "unsuccessful deletion - database error",
 			mockSetup: func(m *mocks.TaskRemover) {
-				m.On("Delete", mock.Anything, int64(100)).Return(errors.New("database error"))
+				m.On("Delete", mock.Anything, int64(100), int64(0)).Return(errors.New("database error"))
 			},
 			id:         "100",
 			wantStatus: http.StatusInternalServerError,
@@ -76,6 +117,9 @@ func TestDeleteHandler(t *testing.T) {
 			}
 
 			req := httptest.NewRequest(http.MethodDelete, url, nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
 			rec := httptest.NewRecorder()
 			r := chi.NewRouter()
 			r.Delete(`/api/tasks/done`, handler)
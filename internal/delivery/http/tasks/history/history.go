@@ -0,0 +1,59 @@
+package history
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+)
+
+const op = "http.History"
+
+type Response struct {
+	Completions []models.Completion `json:"completions,omitempty"`
+	Err         string              `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=HistoryReader
+type HistoryReader interface {
+	History(ctx context.Context, id int64) ([]models.Completion, error)
+}
+
+// @Summary Get completion history of a task
+// @Description Retrieve every recorded completion of a task, oldest first
+// @Produce json
+// @Param id query int true "Task ID"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid task ID"
+// @Failure 404 {object} Response "Task not found"
+// @Failure 500 {object} Response "Failed to read task history"
+// @Router /api/task/history [get]
+func New(log *slog.Logger, hr HistoryReader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		param := r.URL.Query().Get("id")
+		logger := log.With(slog.String("op", op), slog.String("id", param))
+
+		id, err := strconv.Atoi(param)
+		if err != nil {
+			logger.Error("failed to covert id")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "gotten invalid id"})
+			return
+		}
+
+		completions, err := hr.History(r.Context(), int64(id))
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to read task history")})
+			return
+		}
+
+		logger.Info("task history was read")
+		render.JSON(w, r, Response{Completions: completions})
+	}
+}
@@ -0,0 +1,95 @@
+package history_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/history"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/history/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHistoryHandler(t *testing.T) {
+	completions := []models.Completion{
+		{ID: 1, TaskID: 100, Title: "Task", Date: "20250403", CompletedAt: "2025-04-03T09:00:00Z"},
+		{ID: 4, TaskID: 100, Title: "Task", Date: "20250410", CompletedAt: "2025-04-11T18:20:00Z"},
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		mockSetup  func(m *mocks.HistoryReader)
+		wantStatus int
+		wantResp   history.Response
+	}{
+		{
+			name: "successful history reading",
+			id:   "100",
+			mockSetup: func(m *mocks.HistoryReader) {
+				m.On("History", mock.Anything, int64(100)).Return(completions, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   history.Response{Completions: completions},
+		},
+		{
+			name: "unsuccessful history reading - invalid id",
+			id:   "invalid",
+			mockSetup: func(m *mocks.HistoryReader) {
+			},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   history.Response{Err: "gotten invalid id"},
+		},
+		{
+			name: "unsuccessful history reading - task not found",
+			id:   "100",
+			mockSetup: func(m *mocks.HistoryReader) {
+				m.On("History", mock.Anything, int64(100)).Return(nil, domain.New(domain.ErrNotFound, "task not found: 100"))
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   history.Response{Err: "task not found: 100"},
+		},
+		{
+			name: "unsuccessful history reading - database error",
+			id:   "100",
+			mockSetup: func(m *mocks.HistoryReader) {
+				m.On("History", mock.Anything, int64(100)).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   history.Response{Err: "failed to read task history"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewHistoryReader(t)
+			tc.mockSetup(mock)
+
+			handler := history.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/task/history?id="+tc.id, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Get(`/api/task/history`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp history.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+			mock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package mergetags
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const op = "http.MergeTags"
+
+type Request struct {
+	Tags []string `json:"tags" validate:"required,min=1,dive,tag"`
+	Into string   `json:"into" validate:"required,tag"`
+}
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TagMerger
+type TagMerger interface {
+	MergeTags(ctx context.Context, names []string, into string) error
+}
+
+// @Summary Merge tags
+// @Description Move the tasks of the given tags to another tag, created if needed, and remove the merged tags
+// @Accept json
+// @Produce json
+// @Param request body Request true "Tags to merge and the tag to merge them into"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 404 {object} Response "Tag not found"
+// @Failure 500 {object} Response "Failed to merge tags"
+// @Router /api/tags/merge [post]
+func New(log *slog.Logger, tm TagMerger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		var req Request
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			logger.Error("failed to decode request body")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "failed to decode request body"})
+			return
+		}
+
+		v := validator.New()
+		v.RegisterValidation("tag", validation.IsTagValid)
+		if err := v.Struct(req); err != nil {
+			logger.Error("invalid request")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(err.(validator.ValidationErrors))})
+			return
+		}
+
+		err := tm.MergeTags(r.Context(), req.Tags, req.Into)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to merge tags")})
+			return
+		}
+
+		logger.Info("tags were merged", slog.Any("tags", req.Tags), slog.String("into", req.Into))
+		render.JSON(w, r, Response{})
+	}
+}
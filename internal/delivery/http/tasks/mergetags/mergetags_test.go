@@ -0,0 +1,90 @@
+package mergetags_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/mergetags"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/mergetags/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMergeTagsHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestBody string
+		mockSetup   func(m *mocks.TagMerger)
+		wantStatus  int
+		wantResp    mergetags.Response
+	}{
+		{
+			name:        "successful merge",
+			requestBody: `{"tags":["house","chores"],"into":"home"}`,
+			mockSetup: func(m *mocks.TagMerger) {
+				m.On("MergeTags", mock.Anything, []string{"house", "chores"}, "home").Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   mergetags.Response{},
+		},
+		{
+			name:        "unsuccessful merge - no tags",
+			requestBody: `{"tags":[],"into":"home"}`,
+			mockSetup:   func(m *mocks.TagMerger) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    mergetags.Response{Err: "field Tags must be at least 1"},
+		},
+		{
+			name:        "unsuccessful merge - tag not found",
+			requestBody: `{"tags":["house"],"into":"home"}`,
+			mockSetup: func(m *mocks.TagMerger) {
+				m.On("MergeTags", mock.Anything, []string{"house"}, "home").Return(fmt.Errorf("%w: %q", tasks.ErrTagNotFound, "house"))
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   mergetags.Response{Err: `tag not found: "house"`},
+		},
+		{
+			name:        "unsuccessful merge - database error",
+			requestBody: `{"tags":["house"],"into":"home"}`,
+			mockSetup: func(m *mocks.TagMerger) {
+				m.On("MergeTags", mock.Anything, []string{"house"}, "home").Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   mergetags.Response{Err: "failed to merge tags"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewTagMerger(t)
+			tc.mockSetup(mock)
+
+			handler := mergetags.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/tags/merge", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/tags/merge`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp mergetags.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package move
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Move"
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TaskMover
+type TaskMover interface {
+	Move(ctx context.Context, id, projectID int64) error
+}
+
+// @Summary Move a task to another project
+// @Description Put a task into a project, or take it out of its project when project_id is 0. The move can be undone
+// @Produce json
+// @Param id query int true "Task ID"
+// @Param project_id query int true "Project ID, 0 for no project"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid task or project ID"
+// @Failure 404 {object} Response "Task or project not found"
+// @Failure 409 {object} Response "Project is archived"
+// @Failure 500 {object} Response "Failed to move task"
+// @Router /api/task/move [post]
+func New(log *slog.Logger, tm TaskMover) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idParam, projectParam := r.URL.Query().Get("id"), r.URL.Query().Get("project_id")
+		logger := log.With(slog.String("op", op), slog.String("id", idParam), slog.String("project_id", projectParam))
+
+		id, err := strconv.Atoi(idParam)
+		if err != nil {
+			logger.Error("gotten invalid id")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "gotten invalid id"})
+			return
+		}
+
+		projectID, err := strconv.ParseInt(projectParam, 10, 64)
+		if err != nil || projectID < 0 {
+			logger.Error("gotten invalid project id")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "gotten invalid project id"})
+			return
+		}
+
+		err = tm.Move(r.Context(), int64(id), projectID)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to move task")})
+			return
+		}
+
+		logger.Info("task was moved")
+		render.JSON(w, r, Response{})
+	}
+}
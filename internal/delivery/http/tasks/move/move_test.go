@@ -0,0 +1,112 @@
+package move_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/move"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/move/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMoveHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(m *mocks.TaskMover)
+		wantStatus int
+		wantResp   move.Response
+	}{
+		{
+			name:  "successful move",
+			query: "id=100&project_id=2",
+			mockSetup: func(m *mocks.TaskMover) {
+				m.On("Move", mock.Anything, int64(100), int64(2)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   move.Response{},
+		},
+		{
+			name:  "successful move out of a project",
+			query: "id=100&project_id=0",
+			mockSetup: func(m *mocks.TaskMover) {
+				m.On("Move", mock.Anything, int64(100), int64(0)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   move.Response{},
+		},
+		{
+			name:       "unsuccessful move - invalid id",
+			query:      "id=task&project_id=2",
+			mockSetup:  func(m *mocks.TaskMover) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   move.Response{Err: "gotten invalid id"},
+		},
+		{
+			name:       "unsuccessful move - missing project id",
+			query:      "id=100",
+			mockSetup:  func(m *mocks.TaskMover) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   move.Response{Err: "gotten invalid project id"},
+		},
+		{
+			name:  "unsuccessful move - project not found",
+			query: "id=100&project_id=2",
+			mockSetup: func(m *mocks.TaskMover) {
+				m.On("Move", mock.Anything, int64(100), int64(2)).Return(tasks.ErrProjectNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   move.Response{Err: "project not found"},
+		},
+		{
+			name:  "unsuccessful move - project is archived",
+			query: "id=100&project_id=2",
+			mockSetup: func(m *mocks.TaskMover) {
+				m.On("Move", mock.Anything, int64(100), int64(2)).Return(tasks.ErrProjectArchived)
+			},
+			wantStatus: http.StatusConflict,
+			wantResp:   move.Response{Err: "project is archived"},
+		},
+		{
+			name:  "unsuccessful move - database error",
+			query: "id=100&project_id=2",
+			mockSetup: func(m *mocks.TaskMover) {
+				m.On("Move", mock.Anything, int64(100), int64(2)).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   move.Response{Err: "failed to move task"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewTaskMover(t)
+			tc.mockSetup(mock)
+
+			handler := move.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/task/move?"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/task/move`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp move.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
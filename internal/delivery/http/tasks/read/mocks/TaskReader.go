@@ -14,9 +14,9 @@ type TaskReader struct {
 	mock.Mock
 }
 
-// Tasks provides a mock function with given fields: ctx, search
-func (_m *TaskReader) Tasks(ctx context.Context, search string) ([]models.Task, error) {
-	ret := _m.Called(ctx, search)
+// Tasks provides a mock function with given fields: ctx, search, filter
+func (_m *TaskReader) Tasks(ctx context.Context, search string, filter models.TaskFilter) ([]models.Task, error) {
+	ret := _m.Called(ctx, search, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Tasks")
@@ -24,19 +24,19 @@ func (_m *TaskReader) Tasks(ctx context.Context, search string) ([]models.Task,
 
 	var r0 []models.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Task, error)); ok {
-		return rf(ctx, search)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.TaskFilter) ([]models.Task, error)); ok {
+		return rf(ctx, search, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Task); ok {
-		r0 = rf(ctx, search)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.TaskFilter) []models.Task); ok {
+		r0 = rf(ctx, search, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Task)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, search)
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.TaskFilter) error); ok {
+		r1 = rf(ctx, search, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
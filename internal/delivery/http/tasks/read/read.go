@@ -2,9 +2,16 @@ package read
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
 	"github.com/10Narratives/task-tracker/internal/models"
 	"github.com/go-chi/render"
 )
@@ -18,14 +25,77 @@ type Response struct {
 
 //go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TaskReader
 type TaskReader interface {
-	Tasks(ctx context.Context, search string) ([]models.Task, error)
+	Tasks(ctx context.Context, search string, filter models.TaskFilter) ([]models.Task, error)
+}
+
+var (
+	statuses = []string{models.StatusTodo, models.StatusInProgress, models.StatusBlocked, models.StatusDone}
+	sorts    = []string{models.SortByDate, models.SortByPriority, models.SortByStatus}
+)
+
+// Ways of matching several tags given in the tag query parameter.
+const (
+	matchAny = "any"
+	matchAll = "all"
+)
+
+// parseFilter reads the priority, project_id, status, tag, match and sort query parameters.
+// Several tags are given by repeating the tag parameter or separating them with commas.
+func parseFilter(query url.Values) (models.TaskFilter, error) {
+	var filter models.TaskFilter
+	if priority := query.Get("priority"); priority != "" {
+		n, err := strconv.Atoi(priority)
+		if err != nil || n < models.PriorityUrgent || n > models.PriorityLow {
+			return models.TaskFilter{}, fmt.Errorf("field priority must be a number from %d to %d", models.PriorityUrgent, models.PriorityLow)
+		}
+		filter.Priority = n
+	}
+
+	if project := query.Get("project_id"); project != "" {
+		id, err := strconv.ParseInt(project, 10, 64)
+		if err != nil || id < 1 {
+			return models.TaskFilter{}, errors.New("field project_id must be a positive number")
+		}
+		filter.ProjectID = id
+	}
+
+	filter.Status = query.Get("status")
+	if filter.Status != "" && !slices.Contains(statuses, filter.Status) {
+		return models.TaskFilter{}, errors.New("field status must be one of: todo, in_progress, blocked, done")
+	}
+
+	for _, tags := range query["tag"] {
+		filter.Tags = append(filter.Tags, strings.Split(tags, ",")...)
+	}
+
+	switch query.Get("match") {
+	case "", matchAny:
+	case matchAll:
+		filter.AllTags = true
+	default:
+		return models.TaskFilter{}, errors.New("field match must be one of: any, all")
+	}
+
+	filter.Sort = query.Get("sort")
+	if filter.Sort != "" && !slices.Contains(sorts, filter.Sort) {
+		return models.TaskFilter{}, errors.New("field sort must be one of: date, priority, status")
+	}
+
+	return filter, nil
 }
 
 // @Summary Get tasks
-// @Description Retrieve tasks optionally filtered by a search query
+// @Description Retrieve tasks optionally filtered by a search query, priority, project, status and tags
 // @Produce json
 // @Param search query string false "Search filter"
+// @Param priority query int false "Priority from 1 (urgent) to 4 (low)"
+// @Param project_id query int false "Project ID"
+// @Param status query string false "Status" Enums(todo, in_progress, blocked, done)
+// @Param tag query []string false "Tags, repeated or comma separated" collectionFormat(multi)
+// @Param match query string false "Whether tasks must have any or all of the tags, any by default" Enums(any, all)
+// @Param sort query string false "Order of the tasks" Enums(date, priority, status)
 // @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid filter"
 // @Failure 500 {object} Response "Failed to read tasks"
 // @Router /api/tasks [get]
 func New(log *slog.Logger, tr TaskReader) http.HandlerFunc {
@@ -33,12 +103,21 @@ func New(log *slog.Logger, tr TaskReader) http.HandlerFunc {
 		search := r.URL.Query().Get("search")
 
 		logger := log.With(slog.String("op", op), slog.String("search", search))
-		tasks, err := tr.Tasks(context.Background(), search)
+
+		filter, err := parseFilter(r.URL.Query())
+		if err != nil {
+			logger.Error("invalid filter", slog.String("error", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: err.Error()})
+			return
+		}
+
+		tasks, err := tr.Tasks(r.Context(), search, filter)
 		if err != nil {
 			logger.Error(err.Error())
 			logger.Error("failed to read tasks")
-			w.WriteHeader(http.StatusInternalServerError)
-			render.JSON(w, r, Response{Err: "failed to read tasks"})
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to read tasks")})
 		} else {
 			logger.Info("success task reading")
 			w.WriteHeader(http.StatusOK)
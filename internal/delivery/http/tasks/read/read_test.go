@@ -11,6 +11,7 @@ import (
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/read/mocks"
 	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
 	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -20,6 +21,7 @@ func TestReadHandler(t *testing.T) {
 	tests := []struct {
 		name           string
 		search         string
+		query          string
 		mockSetup      func(m *mocks.TaskReader)
 		expectedStatus int
 		expectedResp   read.Response
@@ -28,7 +30,7 @@ func TestReadHandler(t *testing.T) {
 			name:   "No search parameter",
 			search: "",
 			mockSetup: func(m *mocks.TaskReader) {
-				m.On("Tasks", mock.Anything, "").Return([]models.Task{
+				m.On("Tasks", mock.Anything, "", models.TaskFilter{}).Return([]models.Task{
 					{ID: 1, Title: "Task 1", Date: "20250207", Comment: "The 1 task"},
 					{ID: 2, Title: "Task 2", Date: "20250208", Comment: "The 2 task"},
 					{ID: 3, Title: "Task 3", Date: "20250209", Comment: "The 3 task"},
@@ -45,7 +47,7 @@ func TestReadHandler(t *testing.T) {
 			name:   "Search by date",
 			search: "20250205",
 			mockSetup: func(m *mocks.TaskReader) {
-				m.On("Tasks", mock.Anything, "20250205").Return([]models.Task{
+				m.On("Tasks", mock.Anything, "20250205", models.TaskFilter{}).Return([]models.Task{
 					{ID: 1, Title: "Task 1", Date: "20250205", Comment: "The 1 task"},
 					{ID: 2, Title: "Task 2", Date: "20250205", Comment: "The 2 task"},
 					{ID: 3, Title: "Task 3", Date: "20250205", Comment: "The 3 task"},
@@ -62,7 +64,7 @@ func TestReadHandler(t *testing.T) {
 			name:   "Search by payload",
 			search: "Task",
 			mockSetup: func(m *mocks.TaskReader) {
-				m.On("Tasks", mock.Anything, "Task").Return([]models.Task{
+				m.On("Tasks", mock.Anything, "Task", models.TaskFilter{}).Return([]models.Task{
 					{ID: 1, Title: "Task 1", Date: "20250205", Comment: "The 1 task"},
 					{ID: 2, Title: "Task 2", Date: "20250205", Comment: "The 2 task"},
 					{ID: 3, Title: "Task 3", Date: "20250205", Comment: "The 3 task"},
@@ -75,11 +77,94 @@ func TestReadHandler(t *testing.T) {
 				{ID: 3, Title: "Task 3", Date: "20250205", Comment: "The 3 task"},
 			}},
 		},
+		{
+			name:  "Filter by priority and status",
+			query: "priority=1&status=in_progress&sort=priority",
+			mockSetup: func(m *mocks.TaskReader) {
+				m.On("Tasks", mock.Anything, "", models.TaskFilter{Priority: 1, Status: "in_progress", Sort: "priority"}).Return([]models.Task{
+					{ID: 1, Title: "Task 1", Date: "20250205", Priority: 1, Status: "in_progress"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: read.Response{Tasks: []models.Task{
+				{ID: 1, Title: "Task 1", Date: "20250205", Priority: 1, Status: "in_progress"},
+			}},
+		},
+		{
+			name:  "Filter by all of the tags",
+			query: "tag=home&tag=errands,work&match=all",
+			mockSetup: func(m *mocks.TaskReader) {
+				m.On("Tasks", mock.Anything, "", models.TaskFilter{Tags: []string{"home", "errands", "work"}, AllTags: true}).Return([]models.Task{
+					{ID: 1, Title: "Task 1", Date: "20250205", Tags: []string{"errands", "home", "work"}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: read.Response{Tasks: []models.Task{
+				{ID: 1, Title: "Task 1", Date: "20250205", Tags: []string{"errands", "home", "work"}},
+			}},
+		},
+		{
+			name:  "Filter by project",
+			query: "project_id=2",
+			mockSetup: func(m *mocks.TaskReader) {
+				m.On("Tasks", mock.Anything, "", models.TaskFilter{ProjectID: 2}).Return([]models.Task{
+					{ID: 1, Title: "Task 1", Date: "20250205", ProjectID: 2},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: read.Response{Tasks: []models.Task{
+				{ID: 1, Title: "Task 1", Date: "20250205", ProjectID: 2},
+			}},
+		},
+		{
+			name:           "Invalid project",
+			query:          "project_id=garden",
+			mockSetup:      func(m *mocks.TaskReader) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   read.Response{Err: "field project_id must be a positive number"},
+		},
+		{
+			name:           "Invalid tag match",
+			query:          "tag=home&match=some",
+			mockSetup:      func(m *mocks.TaskReader) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   read.Response{Err: "field match must be one of: any, all"},
+		},
+		{
+			name:           "Invalid priority",
+			query:          "priority=high",
+			mockSetup:      func(m *mocks.TaskReader) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   read.Response{Err: "field priority must be a number from 1 to 4"},
+		},
+		{
+			name:           "Invalid status",
+			query:          "status=paused",
+			mockSetup:      func(m *mocks.TaskReader) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   read.Response{Err: "field status must be one of: todo, in_progress, blocked, done"},
+		},
+		{
+			name:           "Invalid sort",
+			query:          "sort=title",
+			mockSetup:      func(m *mocks.TaskReader) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   read.Response{Err: "field sort must be one of: date, priority, status"},
+		},
+		{
+			name:  "Project not found",
+			query: "project_id=9",
+			mockSetup: func(m *mocks.TaskReader) {
+				m.On("Tasks", mock.Anything, "", models.TaskFilter{ProjectID: 9}).Return(nil, domain.New(domain.ErrNotFound, "project not found: 9"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResp:   read.Response{Err: "project not found: 9"},
+		},
 		{
 			name:   "Database error",
 			search: "",
 			mockSetup: func(m *mocks.TaskReader) {
-				m.On("Tasks", mock.Anything, "").Return(nil, errors.New("db error"))
+				m.On("Tasks", mock.Anything, "", models.TaskFilter{}).Return(nil, errors.New("db error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedResp:   read.Response{Err: "failed to read tasks"},
@@ -100,6 +185,8 @@ func TestReadHandler(t *testing.T) {
 			url := `/api/tasks`
 			if tc.search != "" {
 				url += `?search=` + tc.search
+			} else if tc.query != "" {
+				url += `?` + tc.query
 			}
 			req := httptest.NewRequest(http.MethodGet, url, nil)
 			rec := httptest.NewRecorder()
@@ -4,9 +4,10 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
-	"reflect"
 	"strconv"
 
+	"github.com/10Narratives/task-tracker/internal/delivery/http/etag"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
 	"github.com/10Narratives/task-tracker/internal/models"
 	"github.com/go-chi/render"
 )
@@ -17,12 +18,26 @@ import (
 const op = "http.Readone"
 
 type Response struct {
-	ID      string `json:"id,omitempty"`
-	Date    string `json:"date,omitempty"`
-	Title   string `json:"title,omitempty"`
-	Comment string `json:"comment,omitempty"`
-	Repeat  string `json:"repeat,omitempty"`
-	Err     string `json:"error,omitempty"`
+	ID         string        `json:"id,omitempty"`
+	Date       string        `json:"date,omitempty"`
+	Time       string        `json:"time,omitempty"`
+	Title      string        `json:"title,omitempty"`
+	Comment    string        `json:"comment,omitempty"`
+	Repeat     string        `json:"repeat,omitempty"`
+	Anchor     string        `json:"anchor,omitempty"`
+	ExDates    []string      `json:"exdates,omitempty"`
+	Occurrence int           `json:"occurrence,omitempty"`
+	Priority   int           `json:"priority,omitempty"`
+	Status     string        `json:"status,omitempty"`
+	ProjectID  int64         `json:"project_id,omitempty"`
+	ParentID   int64         `json:"parent_id,omitempty"`
+	Reminders  []int         `json:"reminders,omitempty"`
+	Version    int64         `json:"version,omitempty"`
+	Tags       []string      `json:"tags,omitempty"`
+	Children   []models.Task `json:"children,omitempty"`
+	BlockedBy  []int64       `json:"blocked_by,omitempty"`
+	Blocking   []int64       `json:"blocking,omitempty"`
+	Err        string        `json:"error,omitempty"`
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TaskReader
@@ -31,10 +46,11 @@ type TaskReader interface {
 }
 
 // @Summary Get task by ID
-// @Description Retrieve a task using its unique identifier
+// @Description Retrieve a task using its unique identifier together with its subtasks and dependencies
 // @Produce json
 // @Param id query int true "Task ID"
 // @Success 200 {object} Response
+// @Header 200 {string} ETag "Version of the task to be sent back in If-Match"
 // @Failure 400 {object} Response "Invalid task ID"
 // @Failure 404 {object} Response "Task not found"
 // @Failure 500 {object} Response "Failed to find task by ID"
@@ -52,29 +68,37 @@ func New(logger *slog.Logger, tr TaskReader) http.HandlerFunc {
 			return
 		}
 
-		task, err := tr.Task(context.Background(), int64(id))
+		task, err := tr.Task(r.Context(), int64(id))
 		if err != nil {
-			logger.Error("failed to find task by id")
-			w.WriteHeader(http.StatusInternalServerError)
-			render.JSON(w, r, Response{Err: "failed to find task by id"})
-			return
-		}
-
-		if reflect.DeepEqual(models.Task{}, task) {
-			logger.Error("task not found")
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, Response{Err: "task not found"})
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to find task by id")})
 			return
 		}
 
 		logger.Info("task was found")
+		w.Header().Set("ETag", etag.Format(task.Version))
 		w.WriteHeader(http.StatusOK)
 		render.JSON(w, r, Response{
-			ID:      param,
-			Date:    task.Date,
-			Title:   task.Title,
-			Comment: task.Comment,
-			Repeat:  task.Repeat,
+			ID:         param,
+			Date:       task.Date,
+			Time:       task.Time,
+			Title:      task.Title,
+			Comment:    task.Comment,
+			Repeat:     task.Repeat,
+			Anchor:     task.Anchor,
+			ExDates:    task.ExDates,
+			Occurrence: task.Occurrence,
+			Priority:   task.Priority,
+			Status:     task.Status,
+			ProjectID:  task.ProjectID,
+			ParentID:   task.ParentID,
+			Reminders:  task.Reminders,
+			Version:    task.Version,
+			Tags:       task.Tags,
+			Children:   task.Children,
+			BlockedBy:  task.BlockedBy,
+			Blocking:   task.Blocking,
 		})
 	}
 }
@@ -3,6 +3,7 @@ package readone_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/readone/mocks"
 	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
 	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
 	"github.com/go-chi/chi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -22,6 +24,7 @@ func TestReadoneHandler(t *testing.T) {
 		mockSetup  func(m *mocks.TaskReader)
 		id         string
 		wantStatus int
+		wantETag   string
 		wantResp   readone.Response
 	}{
 		{
@@ -29,11 +32,14 @@ func TestReadoneHandler(t *testing.T) {
 			mockSetup: func(m *mocks.TaskReader) {
 				m.
 					On("Task", mock.Anything, int64(100)).
-					Return(models.Task{ID: 100, Date: "20250402", Title: "title", Comment: "comment", Repeat: "d 7"}, nil)
+					Return(models.Task{ID: 100, Date: "20250402", Title: "title", Comment: "comment", Repeat: "d 7", Anchor: "due", Occurrence: 2, Priority: 1, Status: "in_progress", ProjectID: 2, Tags: []string{"work"}, BlockedBy: []int64{4}, Blocking: []int64{9, 12}, Version: 3,
+						Children: []models.Task{{ID: 101, Date: "20250402", Title: "step", Status: "done", ParentID: 100}}}, nil)
 			},
 			id:         "100",
 			wantStatus: http.StatusOK,
-			wantResp:   readone.Response{ID: "100", Date: "20250402", Title: "title", Comment: "comment", Repeat: "d 7"},
+			wantETag:   `"3"`,
+			wantResp: readone.Response{ID: "100", Date: "20250402", Title: "title", Comment: "comment", Repeat: "d 7", Anchor: "due", Occurrence: 2, Priority: 1, Status: "in_progress", ProjectID: 2, Tags: []string{"work"}, BlockedBy: []int64{4}, Blocking: []int64{9, 12}, Version: 3,
+				Children: []models.Task{{ID: 101, Date: "20250402", Title: "step", Status: "done", ParentID: 100}}},
 		},
 		{
 			name: "unsuccessful reading - invalid id",
@@ -59,11 +65,11 @@ func TestReadoneHandler(t *testing.T) {
 			mockSetup: func(m *mocks.TaskReader) {
 				m.
 					On("Task", mock.Anything, int64(100)).
-					Return(models.Task{}, nil)
+					Return(models.Task{}, fmt.Errorf("%w: 100", tasks.ErrTaskNotFound))
 			},
 			id:         "100",
 			wantStatus: http.StatusNotFound,
-			wantResp:   readone.Response{Err: "task not found"},
+			wantResp:   readone.Response{Err: "task not found: 100"},
 		},
 	}
 
@@ -90,6 +96,7 @@ func TestReadoneHandler(t *testing.T) {
 			r.ServeHTTP(rec, req)
 
 			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantETag, rec.Header().Get("ETag"))
 			var actualResp readone.Response
 			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
 
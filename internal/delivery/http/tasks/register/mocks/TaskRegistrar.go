@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/10Narratives/task-tracker/internal/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -13,23 +14,23 @@ type TaskRegistrar struct {
 	mock.Mock
 }
 
-// Register provides a mock function with given fields: ctx, date, title, comment, repeat
-func (_m *TaskRegistrar) Register(ctx context.Context, date string, title string, comment string, repeat string) (int64, error) {
-	ret := _m.Called(ctx, date, title, comment, repeat)
+// Register provides a mock function with given fields: ctx, task
+func (_m *TaskRegistrar) Register(ctx context.Context, task models.Task) (int64, error) {
+	ret := _m.Called(ctx, task)
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (int64, error)); ok {
-		return rf(ctx, date, title, comment, repeat)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Task) (int64, error)); ok {
+		return rf(ctx, task)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) int64); ok {
-		r0 = rf(ctx, date, title, comment, repeat)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Task) int64); ok {
+		r0 = rf(ctx, task)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
-		r1 = rf(ctx, date, title, comment, repeat)
+	if rf, ok := ret.Get(1).(func(context.Context, models.Task) error); ok {
+		r1 = rf(ctx, task)
 	} else {
 		r1 = ret.Error(1)
 	}
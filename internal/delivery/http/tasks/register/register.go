@@ -8,7 +8,9 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/10Narratives/task-tracker/internal/models"
 	"github.com/go-chi/render"
 	"github.com/go-playground/validator/v10"
 )
@@ -19,10 +21,19 @@ import (
 const op = "http.Register"
 
 type Request struct {
-	Date    string `json:"date" validate:"required,dateformat"`
-	Title   string `json:"title" validate:"required,title"`
-	Comment string `json:"comment,omitempty"`
-	Repeat  string `json:"repeat" validate:"repeat"`
+	Date      string   `json:"date" validate:"required,dateformat"`
+	Time      string   `json:"time,omitempty" validate:"omitempty,timeofday"`
+	Title     string   `json:"title" validate:"required,title"`
+	Comment   string   `json:"comment,omitempty"`
+	Repeat    string   `json:"repeat" validate:"repeat"`
+	Anchor    string   `json:"anchor,omitempty" validate:"omitempty,oneof=due completion"`
+	ExDates   []string `json:"exdates,omitempty" validate:"dive,dateformat"`
+	Priority  int      `json:"priority,omitempty" validate:"omitempty,min=1,max=4"`
+	Status    string   `json:"status,omitempty" validate:"omitempty,oneof=todo in_progress blocked done"`
+	ProjectID int64    `json:"project_id,omitempty" validate:"omitempty,min=1"`
+	ParentID  int64    `json:"parent_id,omitempty" validate:"omitempty,min=1"`
+	Reminders []int    `json:"reminders,omitempty" validate:"max=10,unique,dive,min=0,max=40320"`
+	Tags      []string `json:"tags,omitempty" validate:"max=20,dive,tag"`
 }
 
 type Response struct {
@@ -32,7 +43,7 @@ type Response struct {
 
 //go:generate go run github.com/vektra/mockery/v2@v2.28.2 --name=TaskRegistrar
 type TaskRegistrar interface {
-	Register(ctx context.Context, date, title, comment, repeat string) (int64, error)
+	Register(ctx context.Context, task models.Task) (int64, error)
 }
 
 // @Summary Register a new task
@@ -41,7 +52,9 @@ type TaskRegistrar interface {
 // @Produce json
 // @Param request body Request true "Task data"
 // @Success 200 {object} Response
-// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 400 {object} Response "Invalid request format, missing fields or a subtask which breaks the nesting rules"
+// @Failure 404 {object} Response "Project or parent task not found"
+// @Failure 409 {object} Response "Project is archived"
 // @Failure 500 {object} Response "Failed to add task"
 // @Router /api/task [post]
 func New(log *slog.Logger, ts TaskRegistrar) http.HandlerFunc {
@@ -68,10 +81,21 @@ func New(log *slog.Logger, ts TaskRegistrar) http.HandlerFunc {
 
 		log.Info("request body decoded", slog.Any("request", req))
 
+		req.Repeat, err = validation.NormalizeRepeat(req.Repeat)
+		if err != nil {
+			log.Error("invalid repeat rule", slog.String("error", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: err.Error()})
+
+			return
+		}
+
 		v := validator.New()
 		v.RegisterValidation("dateformat", validation.IsDateValid)
+		v.RegisterValidation("timeofday", validation.IsTimeValid)
 		v.RegisterValidation("title", validation.IsTitleValid)
 		v.RegisterValidation("repeat", validation.IsRepeatValid)
+		v.RegisterValidation("tag", validation.IsTagValid)
 		if err := v.Struct(req); err != nil {
 			validationErr := err.(validator.ValidationErrors)
 
@@ -82,12 +106,25 @@ func New(log *slog.Logger, ts TaskRegistrar) http.HandlerFunc {
 			return
 		}
 
-		id, err := ts.Register(context.Background(), req.Date, req.Title, req.Comment, req.Repeat)
+		id, err := ts.Register(r.Context(), models.Task{
+			Date:      req.Date,
+			Time:      req.Time,
+			Title:     req.Title,
+			Comment:   req.Comment,
+			Repeat:    req.Repeat,
+			Anchor:    req.Anchor,
+			ExDates:   req.ExDates,
+			Priority:  req.Priority,
+			Status:    req.Status,
+			ProjectID: req.ProjectID,
+			Reminders: req.Reminders,
+			ParentID:  req.ParentID,
+			Tags:      req.Tags,
+		})
 		if err != nil {
 			log.Error(err.Error())
-			w.WriteHeader(http.StatusInternalServerError)
-			render.JSON(w, r, Response{Err: "failed to add task"})
-
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to add task")})
 			return
 		}
 
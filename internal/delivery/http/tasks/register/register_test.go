@@ -11,6 +11,8 @@ import (
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/register"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/register/mocks"
 	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -27,7 +29,7 @@ func TestRegisterHandler(t *testing.T) {
 			name:        "valid request",
 			requestBody: `{"date":"20250205","title":"Test Task","comment":"This is a test","repeat":"d 7"}`,
 			mockSetup: func(m *mocks.TaskRegistrar) {
-				m.On("Register", mock.Anything, "20250205", "Test Task", "This is a test", "d 7").
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Title: "Test Task", Comment: "This is a test", Repeat: "d 7"}).
 					Return(int64(1), nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -73,11 +75,163 @@ func TestRegisterHandler(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedResp:   register.Response{Err: "field Repeat must satisfy expected patterns"},
 		},
+		{
+			name:        "valid request - RRULE repeat",
+			requestBody: `{"date":"20250205","title":"Test Task","repeat":"FREQ=WEEKLY;BYDAY=MO,WE"}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Title: "Test Task", Repeat: "w 1,3"}).
+					Return(int64(1), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   register.Response{ID: "1"},
+		},
+		{
+			name:        "valid request - completion anchor",
+			requestBody: `{"date":"20250205","title":"Test Task","repeat":"d 3","anchor":"completion"}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Title: "Test Task", Repeat: "d 3", Anchor: "completion"}).
+					Return(int64(1), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   register.Response{ID: "1"},
+		},
+		{
+			name:        "validation error - unknown anchor",
+			requestBody: `{"date":"20250205","title":"Test task","repeat":"d 3","anchor":"later"}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "field Anchor must be one of: due, completion"},
+		},
+		{
+			name:        "valid request - priority and status",
+			requestBody: `{"date":"20250205","title":"Test Task","priority":1,"status":"in_progress"}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Title: "Test Task", Priority: 1, Status: "in_progress"}).
+					Return(int64(1), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   register.Response{ID: "1"},
+		},
+		{
+			name:        "validation error - priority out of range",
+			requestBody: `{"date":"20250205","title":"Test task","priority":5}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "field Priority must be at most 4"},
+		},
+		{
+			name:        "validation error - unknown status",
+			requestBody: `{"date":"20250205","title":"Test task","status":"started"}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "field Status must be one of: todo, in_progress, blocked, done"},
+		},
+		{
+			name:        "valid request - tags",
+			requestBody: `{"date":"20250205","title":"Test Task","tags":["home","errands"]}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Title: "Test Task", Tags: []string{"home", "errands"}}).
+					Return(int64(1), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   register.Response{ID: "1"},
+		},
+		{
+			name:        "validation error - tag with a comma",
+			requestBody: `{"date":"20250205","title":"Test task","tags":["home,work"]}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "field Tags[0] must be a non-empty name of at most 32 characters without commas"},
+		},
+		{
+			name:        "invalid request - archived project",
+			requestBody: `{"date":"20250205","title":"Test Task","project_id":2}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Title: "Test Task", ProjectID: 2}).
+					Return(int64(0), tasks.ErrProjectArchived)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResp:   register.Response{Err: "project is archived"},
+		},
+		{
+			name:        "invalid request - subtask of a subtask",
+			requestBody: `{"date":"20250205","title":"Test Task","parent_id":8}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Title: "Test Task", ParentID: 8}).
+					Return(int64(0), tasks.ErrNestedSubtask)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "subtasks cannot have subtasks"},
+		},
+		{
+			name:        "valid request - exception dates",
+			requestBody: `{"date":"20250205","title":"Test Task","repeat":"w 3","exdates":["20250219"]}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Title: "Test Task", Repeat: "w 3", ExDates: []string{"20250219"}}).
+					Return(int64(1), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   register.Response{ID: "1"},
+		},
+		{
+			name:        "validation error - wrong exception date format",
+			requestBody: `{"date":"20250205","title":"Test task","repeat":"w 3","exdates":["2025-02-19"]}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "field ExDates[0] must be in YYYYMMDD date format"},
+		},
+		{
+			name:        "valid request - due time and reminders",
+			requestBody: `{"date":"20250205","time":"14:30","title":"Dentist","repeat":"","reminders":[15,1440]}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Time: "14:30", Title: "Dentist", Reminders: []int{15, 1440}}).
+					Return(int64(1), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   register.Response{ID: "1"},
+		},
+		{
+			name:        "validation error - wrong time format",
+			requestBody: `{"date":"20250205","time":"2:30pm","title":"Dentist","repeat":""}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "field Time must be a time in HH:MM format"},
+		},
+		{
+			name:        "validation error - repeated reminder",
+			requestBody: `{"date":"20250205","time":"14:30","title":"Dentist","repeat":"","reminders":[15,15]}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "field Reminders must not contain duplicates"},
+		},
+		{
+			name:        "validation error - reminder too early",
+			requestBody: `{"date":"20250205","title":"Dentist","repeat":"","reminders":[50000]}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "field Reminders[0] must be at most 40320"},
+		},
+		{
+			name:        "validation error - unsupported RRULE",
+			requestBody: `{"date":"20250205","title":"Test task","repeat":"FREQ=HOURLY;INTERVAL=2"}`,
+			mockSetup: func(m *mocks.TaskRegistrar) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   register.Response{Err: "unsupported RRULE: FREQ=HOURLY"},
+		},
 		{
 			name:        "task registration fails",
 			requestBody: `{"date":"20250205","title":"Test Task","comment":"This is a test","repeat":"d 7"}`,
 			mockSetup: func(m *mocks.TaskRegistrar) {
-				m.On("Register", mock.Anything, "20250205", "Test Task", "This is a test", "d 7").
+				m.On("Register", mock.Anything, models.Task{Date: "20250205", Title: "Test Task", Comment: "This is a test", Repeat: "d 7"}).
 					Return(int64(0), errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
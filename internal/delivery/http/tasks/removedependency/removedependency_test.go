@@ -0,0 +1,87 @@
+package removedependency_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/removedependency"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/removedependency/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRemoveDependencyHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(m *mocks.DependencyRemover)
+		wantStatus int
+		wantResp   removedependency.Response
+	}{
+		{
+			name:  "successful removal",
+			query: "id=100&blocker_id=4",
+			mockSetup: func(m *mocks.DependencyRemover) {
+				m.On("RemoveDependency", mock.Anything, int64(100), int64(4)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   removedependency.Response{},
+		},
+		{
+			name:       "unsuccessful removal - invalid blocker id",
+			query:      "id=100&blocker_id=task",
+			mockSetup:  func(m *mocks.DependencyRemover) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   removedependency.Response{Err: "gotten invalid blocker id"},
+		},
+		{
+			name:  "unsuccessful removal - no such dependency",
+			query: "id=100&blocker_id=4",
+			mockSetup: func(m *mocks.DependencyRemover) {
+				m.On("RemoveDependency", mock.Anything, int64(100), int64(4)).Return(domain.New(domain.ErrNotFound, "task 100 does not depend on task 4"))
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   removedependency.Response{Err: "task 100 does not depend on task 4"},
+		},
+		{
+			name:  "unsuccessful removal - database error",
+			query: "id=100&blocker_id=4",
+			mockSetup: func(m *mocks.DependencyRemover) {
+				m.On("RemoveDependency", mock.Anything, int64(100), int64(4)).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   removedependency.Response{Err: "failed to remove dependency"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewDependencyRemover(t)
+			tc.mockSetup(mock)
+
+			handler := removedependency.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/task/dependency?"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Delete(`/api/task/dependency`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp removedependency.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package renametag
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const op = "http.RenameTag"
+
+type Request struct {
+	Name    string `json:"name" validate:"required,tag"`
+	NewName string `json:"new_name" validate:"required,tag"`
+}
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TagRenamer
+type TagRenamer interface {
+	RenameTag(ctx context.Context, name, newName string) error
+}
+
+// @Summary Rename a tag
+// @Description Give a tag a new name on all its tasks. Tags cannot be renamed to the name of another tag, merge them instead
+// @Accept json
+// @Produce json
+// @Param request body Request true "Current and new tag name"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 404 {object} Response "Tag not found"
+// @Failure 409 {object} Response "New name is taken by another tag"
+// @Failure 500 {object} Response "Failed to rename tag"
+// @Router /api/tags/rename [post]
+func New(log *slog.Logger, tr TagRenamer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		var req Request
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			logger.Error("failed to decode request body")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "failed to decode request body"})
+			return
+		}
+
+		v := validator.New()
+		v.RegisterValidation("tag", validation.IsTagValid)
+		if err := v.Struct(req); err != nil {
+			logger.Error("invalid request")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(err.(validator.ValidationErrors))})
+			return
+		}
+
+		err := tr.RenameTag(r.Context(), req.Name, req.NewName)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to rename tag")})
+			return
+		}
+
+		logger.Info("tag was renamed", slog.String("name", req.Name), slog.String("new_name", req.NewName))
+		render.JSON(w, r, Response{})
+	}
+}
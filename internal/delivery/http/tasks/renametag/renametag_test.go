@@ -0,0 +1,106 @@
+package renametag_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/renametag"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/renametag/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRenameTagHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestBody string
+		mockSetup   func(m *mocks.TagRenamer)
+		wantStatus  int
+		wantResp    renametag.Response
+	}{
+		{
+			name:        "successful rename",
+			requestBody: `{"name":"home","new_name":"house"}`,
+			mockSetup: func(m *mocks.TagRenamer) {
+				m.On("RenameTag", mock.Anything, "home", "house").Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   renametag.Response{},
+		},
+		{
+			name:        "unsuccessful rename - missing new name",
+			requestBody: `{"name":"home"}`,
+			mockSetup:   func(m *mocks.TagRenamer) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    renametag.Response{Err: "field NewName is required"},
+		},
+		{
+			name:        "unsuccessful rename - invalid body",
+			requestBody: `{"name":`,
+			mockSetup:   func(m *mocks.TagRenamer) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    renametag.Response{Err: "failed to decode request body"},
+		},
+		{
+			name:        "unsuccessful rename - tag not found",
+			requestBody: `{"name":"home","new_name":"house"}`,
+			mockSetup: func(m *mocks.TagRenamer) {
+				m.On("RenameTag", mock.Anything, "home", "house").Return(fmt.Errorf("%w: %q", tasks.ErrTagNotFound, "home"))
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   renametag.Response{Err: `tag not found: "home"`},
+		},
+		{
+			name:        "unsuccessful rename - new name is taken",
+			requestBody: `{"name":"home","new_name":"work"}`,
+			mockSetup: func(m *mocks.TagRenamer) {
+				m.On("RenameTag", mock.Anything, "home", "work").Return(fmt.Errorf("%w: %q", tasks.ErrTagExists, "work"))
+			},
+			wantStatus: http.StatusConflict,
+			wantResp:   renametag.Response{Err: `tag already exists: "work"`},
+		},
+		{
+			name:        "unsuccessful rename - database error",
+			requestBody: `{"name":"home","new_name":"house"}`,
+			mockSetup: func(m *mocks.TagRenamer) {
+				m.On("RenameTag", mock.Anything, "home", "house").Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   renametag.Response{Err: "failed to rename tag"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewTagRenamer(t)
+			tc.mockSetup(mock)
+
+			handler := renametag.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/tags/rename", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/tags/rename`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp renametag.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package restore
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Restore"
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TaskRestorer
+type TaskRestorer interface {
+	Restore(ctx context.Context, id int64) error
+}
+
+// @Summary Restore a task from the trash
+// @Description Take a deleted task out of the trash so that it is scheduled again
+// @Produce json
+// @Param id query int true "Task ID"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid task ID"
+// @Failure 404 {object} Response "Task is not in the trash"
+// @Failure 500 {object} Response "Failed to restore task"
+// @Router /api/trash/restore [post]
+func New(log *slog.Logger, tr TaskRestorer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		param := r.URL.Query().Get("id")
+		logger := log.With(slog.String("op", op), slog.String("id", param))
+
+		id, err := strconv.Atoi(param)
+		if err != nil {
+			logger.Error("gotten invalid id")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "gotten invalid id"})
+			return
+		}
+
+		err = tr.Restore(r.Context(), int64(id))
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to restore task")})
+			return
+		}
+
+		logger.Info("task was restored")
+		render.JSON(w, r, Response{})
+	}
+}
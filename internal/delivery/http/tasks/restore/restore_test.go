@@ -0,0 +1,89 @@
+package restore_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/restore"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/restore/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRestoreHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockSetup  func(m *mocks.TaskRestorer)
+		id         string
+		wantStatus int
+		wantResp   restore.Response
+	}{
+		{
+			name: "successful restore",
+			mockSetup: func(m *mocks.TaskRestorer) {
+				m.On("Restore", mock.Anything, int64(100)).Return(nil)
+			},
+			id:         "100",
+			wantStatus: http.StatusOK,
+			wantResp:   restore.Response{},
+		},
+		{
+			name: "unsuccessful restore - invalid id",
+			mockSetup: func(m *mocks.TaskRestorer) {
+			},
+			id:         "invalid",
+			wantStatus: http.StatusBadRequest,
+			wantResp:   restore.Response{Err: "gotten invalid id"},
+		},
+		{
+			name: "unsuccessful restore - task is not in the trash",
+			mockSetup: func(m *mocks.TaskRestorer) {
+				m.On("Restore", mock.Anything, int64(100)).Return(tasks.ErrNotInTrash)
+			},
+			id:         "100",
+			wantStatus: http.StatusNotFound,
+			wantResp:   restore.Response{Err: "task is not in the trash"},
+		},
+		{
+			name: "unsuccessful restore - database error",
+			mockSetup: func(m *mocks.TaskRestorer) {
+				m.On("Restore", mock.Anything, int64(100)).Return(errors.New("database error"))
+			},
+			id:         "100",
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   restore.Response{Err: "failed to restore task"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewTaskRestorer(t)
+			tc.mockSetup(mock)
+
+			handler := restore.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/trash/restore?id="+tc.id, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/trash/restore`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp restore.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+			mock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package skip
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Skip"
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TaskSkipper
+type TaskSkipper interface {
+	Skip(ctx context.Context, id int64) error
+}
+
+// @Summary Skip the current occurrence of a recurring task
+// @Description Move a recurring task to its next occurrence without marking it as completed
+// @Produce json
+// @Param id query int true "Task ID"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid task ID or task is not recurring"
+// @Failure 404 {object} Response "Task not found"
+// @Failure 500 {object} Response "Failed to skip task"
+// @Router /api/task/skip [post]
+func New(log *slog.Logger, ts TaskSkipper) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With("op", op)
+
+		param := r.URL.Query().Get("id")
+		id, err := strconv.Atoi(param)
+		if err != nil {
+			logger.Error("gotten invalid id")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "gotten invalid id"})
+			return
+		}
+
+		err = ts.Skip(r.Context(), int64(id))
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to skip task")})
+			return
+		}
+
+		logger.Info("task occurrence was skipped")
+		render.JSON(w, r, Response{})
+	}
+}
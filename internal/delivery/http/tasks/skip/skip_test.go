@@ -0,0 +1,112 @@
+package skip_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/skip"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/skip/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSkipHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockSetup  func(m *mocks.TaskSkipper)
+		id         string
+		wantStatus int
+		wantResp   skip.Response
+	}{
+		{
+			name: "successful skip",
+			mockSetup: func(m *mocks.TaskSkipper) {
+				m.On("Skip", mock.Anything, int64(100)).Return(nil)
+			},
+			id:         "100",
+			wantStatus: http.StatusOK,
+			wantResp:   skip.Response{},
+		},
+		{
+			name: "unsuccessful skip - invalid id",
+			mockSetup: func(m *mocks.TaskSkipper) {
+			},
+			id:         "invalid",
+			wantStatus: http.StatusBadRequest,
+			wantResp:   skip.Response{Err: "gotten invalid id"},
+		},
+		{
+			name: "unsuccessful skip - empty id",
+			mockSetup: func(m *mocks.TaskSkipper) {
+			},
+			id:         "",
+			wantStatus: http.StatusBadRequest,
+			wantResp:   skip.Response{Err: "gotten invalid id"},
+		},
+		{
+			name: "unsuccessful skip - task is not recurring",
+			mockSetup: func(m *mocks.TaskSkipper) {
+				m.On("Skip", mock.Anything, int64(100)).Return(tasks.ErrNotRecurring)
+			},
+			id:         "100",
+			wantStatus: http.StatusBadRequest,
+			wantResp:   skip.Response{Err: "task is not recurring"},
+		},
+		{
+			name: "unsuccessful skip - task not found",
+			mockSetup: func(m *mocks.TaskSkipper) {
+				m.On("Skip", mock.Anything, int64(100)).Return(fmt.Errorf("%w: 100", tasks.ErrTaskNotFound))
+			},
+			id:         "100",
+			wantStatus: http.StatusNotFound,
+			wantResp:   skip.Response{Err: "task not found: 100"},
+		},
+		{
+			name: "unsuccessful skip - database error",
+			mockSetup: func(m *mocks.TaskSkipper) {
+				m.On("Skip", mock.Anything, int64(100)).Return(errors.New("database error"))
+			},
+			id:         "100",
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   skip.Response{Err: "failed to skip task"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewTaskSkipper(t)
+			tc.mockSetup(mock)
+
+			handler := skip.New(slogdiscard.NewDiscardLogger(), mock)
+
+			url := "/api/task/skip"
+			if tc.id != "" {
+				url += "?id=" + tc.id
+			}
+
+			req := httptest.NewRequest(http.MethodPost, url, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/task/skip`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp skip.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+			mock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package tags
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Tags"
+
+type Response struct {
+	Tags []models.Tag `json:"tags,omitempty"`
+	Err  string       `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TagReader
+type TagReader interface {
+	Tags(ctx context.Context) ([]models.Tag, error)
+}
+
+// @Summary Get tags
+// @Description Retrieve all tags in alphabetical order with the number of tasks carrying them
+// @Produce json
+// @Success 200 {object} Response
+// @Failure 500 {object} Response "Failed to read tags"
+// @Router /api/tags [get]
+func New(log *slog.Logger, tr TagReader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		tags, err := tr.Tags(r.Context())
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to read tags")})
+			return
+		}
+
+		logger.Info("tags were read")
+		render.JSON(w, r, Response{Tags: tags})
+	}
+}
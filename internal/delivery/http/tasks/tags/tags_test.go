@@ -0,0 +1,79 @@
+package tags_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/tags"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/tags/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTagsHandler(t *testing.T) {
+	all := []models.Tag{{ID: 2, Name: "home", Tasks: 3}, {ID: 1, Name: "work", Tasks: 0}}
+
+	tests := []struct {
+		name       string
+		mockSetup  func(m *mocks.TagReader)
+		wantStatus int
+		wantResp   tags.Response
+	}{
+		{
+			name: "successful tags reading",
+			mockSetup: func(m *mocks.TagReader) {
+				m.On("Tags", mock.Anything).Return(all, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   tags.Response{Tags: all},
+		},
+		{
+			name: "unsuccessful tags reading - user not found",
+			mockSetup: func(m *mocks.TagReader) {
+				m.On("Tags", mock.Anything).Return(nil, domain.New(domain.ErrNotFound, "user 3 not found"))
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   tags.Response{Err: "user 3 not found"},
+		},
+		{
+			name: "unsuccessful tags reading - database error",
+			mockSetup: func(m *mocks.TagReader) {
+				m.On("Tags", mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   tags.Response{Err: "failed to read tags"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewTagReader(t)
+			tc.mockSetup(mock)
+
+			handler := tags.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Get(`/api/tags`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp tags.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package trash
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Trash"
+
+type Response struct {
+	Tasks []models.Task `json:"tasks,omitempty"`
+	Err   string        `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TrashReader
+type TrashReader interface {
+	Trash(ctx context.Context) ([]models.Task, error)
+}
+
+// @Summary Get trashed tasks
+// @Description Retrieve deleted tasks which have not been purged yet, most recently deleted first
+// @Produce json
+// @Success 200 {object} Response
+// @Failure 500 {object} Response "Failed to read trash"
+// @Router /api/trash [get]
+func New(log *slog.Logger, tr TrashReader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		tasks, err := tr.Trash(r.Context())
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to read trash")})
+			return
+		}
+
+		logger.Info("trash was read")
+		render.JSON(w, r, Response{Tasks: tasks})
+	}
+}
@@ -0,0 +1,83 @@
+package trash_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/trash"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/trash/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTrashHandler(t *testing.T) {
+	trashed := []models.Task{
+		{ID: 2, Date: "20250410", Title: "Task", Anchor: "due", Occurrence: 1, DeletedAt: "2025-04-11T18:20:00Z"},
+		{ID: 1, Date: "20250403", Title: "Task", Repeat: "d 7", Anchor: "due", Occurrence: 1, DeletedAt: "2025-04-03T09:00:00Z"},
+	}
+
+	tests := []struct {
+		name       string
+		mockSetup  func(m *mocks.TrashReader)
+		wantStatus int
+		wantResp   trash.Response
+	}{
+		{
+			name: "successful trash reading",
+			mockSetup: func(m *mocks.TrashReader) {
+				m.On("Trash", mock.Anything).Return(trashed, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   trash.Response{Tasks: trashed},
+		},
+		{
+			name: "unsuccessful trash reading - user not found",
+			mockSetup: func(m *mocks.TrashReader) {
+				m.On("Trash", mock.Anything).Return(nil, domain.New(domain.ErrNotFound, "user 3 not found"))
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   trash.Response{Err: "user 3 not found"},
+		},
+		{
+			name: "unsuccessful trash reading - database error",
+			mockSetup: func(m *mocks.TrashReader) {
+				m.On("Trash", mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   trash.Response{Err: "failed to read trash"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewTrashReader(t)
+			tc.mockSetup(mock)
+
+			handler := trash.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/trash", nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Get(`/api/trash`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp trash.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+			mock.AssertExpectations(t)
+		})
+	}
+}
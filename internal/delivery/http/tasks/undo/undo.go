@@ -0,0 +1,47 @@
+package undo
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Undo"
+
+type Response struct {
+	Operation *models.Operation `json:"operation,omitempty"`
+	Err       string            `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=OperationUndoer
+type OperationUndoer interface {
+	Undo(ctx context.Context) (models.Operation, error)
+}
+
+// @Summary Undo the last operation
+// @Description Revert the most recent register, update, delete or complete operation made within the undo window
+// @Produce json
+// @Success 200 {object} Response
+// @Failure 404 {object} Response "Nothing to undo"
+// @Failure 500 {object} Response "Failed to undo operation"
+// @Router /api/undo [post]
+func New(log *slog.Logger, ou OperationUndoer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		undone, err := ou.Undo(r.Context())
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to undo operation")})
+			return
+		}
+
+		logger.Info("operation was undone", slog.String("kind", undone.Kind), slog.Int64("task_id", undone.TaskID))
+		render.JSON(w, r, Response{Operation: &undone})
+	}
+}
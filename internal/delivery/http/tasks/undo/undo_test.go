@@ -0,0 +1,86 @@
+package undo_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/undo"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/undo/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUndoHandler(t *testing.T) {
+	undone := models.Operation{
+		ID:        3,
+		Kind:      models.OperationUpdate,
+		TaskID:    100,
+		Task:      &models.Task{ID: 100, Date: "20250410", Title: "Task", Anchor: "due", Occurrence: 1},
+		CreatedAt: "2025-04-10T10:30:00Z",
+	}
+
+	tests := []struct {
+		name       string
+		mockSetup  func(m *mocks.OperationUndoer)
+		wantStatus int
+		wantResp   undo.Response
+	}{
+		{
+			name: "successful undo",
+			mockSetup: func(m *mocks.OperationUndoer) {
+				m.On("Undo", mock.Anything).Return(undone, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   undo.Response{Operation: &undone},
+		},
+		{
+			name: "unsuccessful undo - nothing to undo",
+			mockSetup: func(m *mocks.OperationUndoer) {
+				m.On("Undo", mock.Anything).Return(models.Operation{}, tasks.ErrNothingToUndo)
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   undo.Response{Err: "nothing to undo"},
+		},
+		{
+			name: "unsuccessful undo - database error",
+			mockSetup: func(m *mocks.OperationUndoer) {
+				m.On("Undo", mock.Anything).Return(models.Operation{}, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   undo.Response{Err: "failed to undo operation"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewOperationUndoer(t)
+			tc.mockSetup(mock)
+
+			handler := undo.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/undo", nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/undo`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp undo.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+			mock.AssertExpectations(t)
+		})
+	}
+}
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/10Narratives/task-tracker/internal/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -13,17 +14,17 @@ type TaskUpdater struct {
 	mock.Mock
 }
 
-// Update provides a mock function with given fields: ctx, id, date, title, comment, repeat
-func (_m *TaskUpdater) Update(ctx context.Context, id int64, date string, title string, comment string, repeat string) error {
-	ret := _m.Called(ctx, id, date, title, comment, repeat)
+// Update provides a mock function with given fields: ctx, task
+func (_m *TaskUpdater) Update(ctx context.Context, task models.Task) error {
+	ret := _m.Called(ctx, task)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string, string, string) error); ok {
-		r0 = rf(ctx, id, date, title, comment, repeat)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Task) error); ok {
+		r0 = rf(ctx, task)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -6,7 +6,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/10Narratives/task-tracker/internal/delivery/http/etag"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/10Narratives/task-tracker/internal/models"
 	"github.com/go-chi/render"
 	"github.com/go-playground/validator/v10"
 )
@@ -17,11 +20,19 @@ import (
 const op = "http.Update"
 
 type Request struct {
-	ID      string `json:"id" validate:"required"`
-	Date    string `json:"date" validate:"required,dateformat"`
-	Title   string `json:"title" validate:"required,title"`
-	Comment string `json:"comment"`
-	Repeat  string `json:"repeat" validate:"repeat"`
+	ID        string   `json:"id" validate:"required"`
+	Date      string   `json:"date" validate:"required,dateformat"`
+	Time      *string  `json:"time,omitempty" validate:"omitempty,timeofday"`
+	Title     string   `json:"title" validate:"required,title"`
+	Comment   string   `json:"comment"`
+	Repeat    string   `json:"repeat" validate:"repeat"`
+	Anchor    string   `json:"anchor,omitempty" validate:"omitempty,oneof=due completion"`
+	ExDates   []string `json:"exdates,omitempty" validate:"dive,dateformat"`
+	Priority  int      `json:"priority,omitempty" validate:"omitempty,min=1,max=4"`
+	Status    string   `json:"status,omitempty" validate:"omitempty,oneof=todo in_progress blocked done"`
+	ProjectID int64    `json:"project_id,omitempty" validate:"omitempty,min=1"`
+	Reminders []int    `json:"reminders,omitempty" validate:"max=10,unique,dive,min=0,max=40320"`
+	Tags      []string `json:"tags" validate:"max=20,dive,tag"`
 }
 
 type Response struct {
@@ -30,7 +41,7 @@ type Response struct {
 
 //go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=TaskUpdater
 type TaskUpdater interface {
-	Update(ctx context.Context, id int64, date, title, comment, repeat string) error
+	Update(ctx context.Context, task models.Task) error
 }
 
 // @Summary Update an existing task
@@ -38,8 +49,12 @@ type TaskUpdater interface {
 // @Accept json
 // @Produce json
 // @Param request body Request true "Task data to update"
+// @Param If-Match header string false "ETag of the task version the update is based on"
 // @Success 200 {object} Response
-// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 400 {object} Response "Invalid request format, missing fields, a repeat rule on a subtask or a malformed If-Match header"
+// @Failure 404 {object} Response "Task or project not found"
+// @Failure 409 {object} Response "Project is archived"
+// @Failure 412 {object} Response "Task was changed since the given version"
 // @Failure 500 {object} Response "Failed to update task"
 // @Router /api/task [put]
 func New(logger *slog.Logger, tu TaskUpdater) http.HandlerFunc {
@@ -57,10 +72,20 @@ func New(logger *slog.Logger, tu TaskUpdater) http.HandlerFunc {
 
 		logger.Info("request body decoded", slog.Any("request", req))
 
+		req.Repeat, err = validation.NormalizeRepeat(req.Repeat)
+		if err != nil {
+			logger.Error("invalid repeat rule", slog.String("error", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: err.Error()})
+			return
+		}
+
 		v := validator.New()
 		v.RegisterValidation("dateformat", validation.IsDateValid)
+		v.RegisterValidation("timeofday", validation.IsTimeValid)
 		v.RegisterValidation("title", validation.IsTitleValid)
 		v.RegisterValidation("repeat", validation.IsRepeatValid)
+		v.RegisterValidation("tag", validation.IsTagValid)
 		if err := v.Struct(req); err != nil {
 			validationErr := err.(validator.ValidationErrors)
 			logger.Error("invalid request")
@@ -70,12 +95,42 @@ func New(logger *slog.Logger, tu TaskUpdater) http.HandlerFunc {
 			return
 		}
 
+		version, err := etag.Parse(r.Header.Get("If-Match"))
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: err.Error()})
+			return
+		}
+
+		// A time left out is kept, an empty one drops the time of day.
+		var clock string
+		if req.Time != nil {
+			clock = *req.Time
+		}
+
 		id, _ := strconv.Atoi(req.ID)
-		err = tu.Update(context.Background(), int64(id), req.Date, req.Title, req.Comment, req.Repeat)
+		err = tu.Update(r.Context(), models.Task{
+			ID:        int64(id),
+			Date:      req.Date,
+			Time:      clock,
+			ClearTime: req.Time != nil && *req.Time == "",
+			Title:     req.Title,
+			Comment:   req.Comment,
+			Repeat:    req.Repeat,
+			Anchor:    req.Anchor,
+			ExDates:   req.ExDates,
+			Priority:  req.Priority,
+			Status:    req.Status,
+			ProjectID: req.ProjectID,
+			Reminders: req.Reminders,
+			Tags:      req.Tags,
+			Version:   version,
+		})
 		if err != nil {
 			logger.Error(err.Error())
-			w.WriteHeader(http.StatusInternalServerError)
-			render.JSON(w, r, Response{Err: "failed to update task"})
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to update task")})
 			return
 		}
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,6 +12,8 @@ import (
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/update"
 	"github.com/10Narratives/task-tracker/internal/delivery/http/tasks/update/mocks"
 	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -20,6 +23,7 @@ func TestUpdateHandler(t *testing.T) {
 		name           string
 		requestBody    string
 		mockSetup      func(m *mocks.TaskUpdater)
+		ifMatch        string
 		expectedStatus int
 		expectedResp   update.Response
 	}{
@@ -27,7 +31,7 @@ func TestUpdateHandler(t *testing.T) {
 			name:        "successful update",
 			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","comment":"This is a test","repeat":"d 7"}`,
 			mockSetup: func(m *mocks.TaskUpdater) {
-				m.On("Update", mock.Anything, int64(100), "20250205", "Test Task", "This is a test", "d 7").Return(nil)
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task", Comment: "This is a test", Repeat: "d 7"}).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedResp:   update.Response{},
@@ -64,11 +68,152 @@ func TestUpdateHandler(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedResp:   update.Response{Err: "field Repeat must satisfy expected patterns"},
 		},
+		{
+			name:        "successful update - RRULE repeat",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","comment":"This is a test","repeat":"RRULE:FREQ=MONTHLY;BYDAY=-1FR"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task", Comment: "This is a test", Repeat: "m -1:5"}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   update.Response{},
+		},
+		{
+			name:        "unsuccessful update - unsupported RRULE",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","comment":"This is a test","repeat":"FREQ=MONTHLY;BYMONTHDAY=1;BYDAY=1MO"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   update.Response{Err: "unsupported RRULE: BYMONTHDAY combined with BYDAY"},
+		},
+		{
+			name:        "successful update - completion anchor",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","repeat":"d 3","anchor":"completion"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task", Repeat: "d 3", Anchor: "completion"}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   update.Response{},
+		},
+		{
+			name:        "unsuccessful update - unknown anchor",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","repeat":"d 3","anchor":"done"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   update.Response{Err: "field Anchor must be one of: due, completion"},
+		},
+		{
+			name:        "successful update - due time and reminders",
+			requestBody: `{"id": "100", "date":"20250205","time":"09:00","title":"Test Task","repeat":"","reminders":[30]}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Time: "09:00", Title: "Test Task", Reminders: []int{30}}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   update.Response{},
+		},
+		{
+			name:        "successful update - empty time, exception dates and reminders clear them",
+			requestBody: `{"id": "100", "date":"20250205","time":"","title":"Test Task","repeat":"","exdates":[],"reminders":[]}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", ClearTime: true, Title: "Test Task", ExDates: []string{}, Reminders: []int{}}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   update.Response{},
+		},
+		{
+			name:        "unsuccessful update - invalid body time",
+			requestBody: `{"id": "100", "date":"20250205","time":"25:00","title":"Test Task","repeat":""}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   update.Response{Err: "field Time must be a time in HH:MM format"},
+		},
+		{
+			name:        "successful update - priority and status",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","priority":2,"status":"blocked"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task", Priority: 2, Status: "blocked"}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   update.Response{},
+		},
+		{
+			name:        "successful update - tags are cleared",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","tags":[]}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task", Tags: []string{}}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp:   update.Response{},
+		},
+		{
+			name:        "unsuccessful update - blank tag",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","tags":["  "]}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   update.Response{Err: "field Tags[0] must be a non-empty name of at most 32 characters without commas"},
+		},
+		{
+			name:        "unsuccessful update - unknown project",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","project_id":9}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task", ProjectID: 9}).Return(tasks.ErrProjectNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResp:   update.Response{Err: "project not found"},
+		},
+		{
+			name:        "unsuccessful update - unknown status",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","status":"paused"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   update.Response{Err: "field Status must be one of: todo, in_progress, blocked, done"},
+		},
+		{
+			name:        "successful update - matching version",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task", Version: 7}).Return(nil)
+			},
+			ifMatch:        `"7"`,
+			expectedStatus: http.StatusOK,
+			expectedResp:   update.Response{},
+		},
+		{
+			name:        "unsuccessful update - task has changed",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task", Version: 7}).Return(fmt.Errorf("%w: version 7 is not the current version 8", tasks.ErrVersionMismatch))
+			},
+			ifMatch:        `"7"`,
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedResp:   update.Response{Err: "task was changed in the meantime: version 7 is not the current version 8"},
+		},
+		{
+			name:        "unsuccessful update - invalid If-Match",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+			},
+			ifMatch:        `"0"`,
+			expectedStatus: http.StatusBadRequest,
+			expectedResp:   update.Response{Err: "If-Match header must hold the ETag of a task"},
+		},
+		{
+			name:        "unsuccessful update - task not found",
+			requestBody: `{"id": "100", "date":"20250205","title":"Test Task"}`,
+			mockSetup: func(m *mocks.TaskUpdater) {
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task"}).Return(fmt.Errorf("%w: 100", tasks.ErrTaskNotFound))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResp:   update.Response{Err: "task not found: 100"},
+		},
 		{
 			name:        "unsuccessful update - database error",
 			requestBody: `{"id": "100", "date":"20250205","title":"Test Task","comment":"This is a test","repeat":"d 7"}`,
 			mockSetup: func(m *mocks.TaskUpdater) {
-				m.On("Update", mock.Anything, int64(100), "20250205", "Test Task", "This is a test", "d 7").Return(errors.New("database error"))
+				m.On("Update", mock.Anything, models.Task{ID: 100, Date: "20250205", Title: "Test Task", Comment: "This is a test", Repeat: "d 7"}).Return(errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedResp:   update.Response{Err: "failed to update task"},
@@ -84,6 +229,9 @@ func TestUpdateHandler(t *testing.T) {
 
 			req := httptest.NewRequest(http.MethodPost, "/register", bytes.NewBufferString(tc.requestBody))
 			req.Header.Set("Content-Type", "application/json")
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
 			recorder := httptest.NewRecorder()
 
 			handler.ServeHTTP(recorder, req)
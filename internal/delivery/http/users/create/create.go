@@ -0,0 +1,78 @@
+package create
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const op = "http.CreateUser"
+
+type Request struct {
+	Name     string `json:"name" validate:"required,username"`
+	Password string `json:"password" validate:"required,password"`
+	Timezone string `json:"timezone,omitempty" validate:"omitempty,timezone"` // IANA time zone reminders are due in
+	Admin    bool   `json:"admin"`
+}
+
+type Response struct {
+	ID  int64  `json:"id,omitempty"`
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=UserCreator
+type UserCreator interface {
+	Register(ctx context.Context, name, password, timezone string, admin bool) (models.User, error)
+}
+
+// @Summary Create a user
+// @Description Add an account, with or without admin rights. Only admins may create accounts this way
+// @Accept json
+// @Produce json
+// @Param request body Request true "User data"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 403 {string} string "Admin rights required"
+// @Failure 409 {object} Response "User name is already taken"
+// @Failure 500 {object} Response "Failed to create user"
+// @Router /api/users [post]
+func New(log *slog.Logger, uc UserCreator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		var req Request
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			logger.Error("failed to decode request body")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "failed to decode request body"})
+			return
+		}
+
+		v := validator.New()
+		v.RegisterValidation("username", validation.IsUserNameValid)
+		v.RegisterValidation("password", validation.IsPasswordValid)
+		if err := v.Struct(req); err != nil {
+			logger.Error("invalid request")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(err.(validator.ValidationErrors))})
+			return
+		}
+
+		user, err := uc.Register(r.Context(), req.Name, req.Password, req.Timezone, req.Admin)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to create user")})
+			return
+		}
+
+		logger.Info("user was created", slog.Int64("id", user.ID))
+		render.JSON(w, r, Response{ID: user.ID})
+	}
+}
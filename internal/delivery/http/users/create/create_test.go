@@ -0,0 +1,90 @@
+package create_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/users/create"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/users/create/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/users"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateUserHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestBody string
+		mockSetup   func(m *mocks.UserCreator)
+		wantStatus  int
+		wantResp    create.Response
+	}{
+		{
+			name:        "successful creation",
+			requestBody: `{"name":"bob","password":"correct horse","timezone":"Europe/Berlin","admin":true}`,
+			mockSetup: func(m *mocks.UserCreator) {
+				m.On("Register", mock.Anything, "bob", "correct horse", "Europe/Berlin", true).Return(models.User{ID: 4, Name: "bob", Admin: true}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   create.Response{ID: 4},
+		},
+		{
+			name:        "unsuccessful creation - missing password",
+			requestBody: `{"name":"bob"}`,
+			mockSetup:   func(m *mocks.UserCreator) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    create.Response{Err: "field Password is required"},
+		},
+		{
+			name:        "unsuccessful creation - name taken",
+			requestBody: `{"name":"bob","password":"correct horse"}`,
+			mockSetup: func(m *mocks.UserCreator) {
+				m.On("Register", mock.Anything, "bob", "correct horse", "", false).Return(models.User{}, users.ErrNameTaken)
+			},
+			wantStatus: http.StatusConflict,
+			wantResp:   create.Response{Err: "user name is already taken"},
+		},
+		{
+			name:        "unsuccessful creation - database error",
+			requestBody: `{"name":"bob","password":"correct horse"}`,
+			mockSetup: func(m *mocks.UserCreator) {
+				m.On("Register", mock.Anything, "bob", "correct horse", "", false).Return(models.User{}, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   create.Response{Err: "failed to create user"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewUserCreator(t)
+			tc.mockSetup(mock)
+
+			handler := create.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/users", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Post(`/api/users`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp create.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package delete
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/go-chi/render"
+)
+
+const op = "http.DeleteUser"
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=UserRemover
+type UserRemover interface {
+	Delete(ctx context.Context, id int64) error
+}
+
+// @Summary Delete a user
+// @Description Remove an account together with its tasks, tags and projects. Only admins may delete accounts,
+// @Description and the last admin cannot be deleted
+// @Produce json
+// @Param id query int true "User ID"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid user ID"
+// @Failure 403 {string} string "Admin rights required"
+// @Failure 404 {object} Response "User not found"
+// @Failure 409 {object} Response "The last admin cannot be removed"
+// @Failure 500 {object} Response "Failed to delete user"
+// @Router /api/users [delete]
+func New(log *slog.Logger, ur UserRemover) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		param := r.URL.Query().Get("id")
+		logger := log.With(slog.String("op", op), slog.String("id", param))
+
+		id, err := strconv.Atoi(param)
+		if err != nil {
+			logger.Error("gotten invalid id")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "gotten invalid id"})
+			return
+		}
+
+		err = ur.Delete(r.Context(), int64(id))
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to delete user")})
+			return
+		}
+
+		logger.Info("user was deleted")
+		render.JSON(w, r, Response{})
+	}
+}
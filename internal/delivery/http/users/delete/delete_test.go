@@ -0,0 +1,96 @@
+package delete_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/users/delete"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/users/delete/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/users"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteUserHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		mockSetup  func(m *mocks.UserRemover)
+		wantStatus int
+		wantResp   delete.Response
+	}{
+		{
+			name: "successful deletion",
+			id:   "4",
+			mockSetup: func(m *mocks.UserRemover) {
+				m.On("Delete", mock.Anything, int64(4)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   delete.Response{},
+		},
+		{
+			name:       "unsuccessful deletion - invalid id",
+			id:         "bob",
+			mockSetup:  func(m *mocks.UserRemover) {},
+			wantStatus: http.StatusBadRequest,
+			wantResp:   delete.Response{Err: "gotten invalid id"},
+		},
+		{
+			name: "unsuccessful deletion - user not found",
+			id:   "4",
+			mockSetup: func(m *mocks.UserRemover) {
+				m.On("Delete", mock.Anything, int64(4)).Return(users.ErrUserNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   delete.Response{Err: "user not found"},
+		},
+		{
+			name: "unsuccessful deletion - last admin",
+			id:   "4",
+			mockSetup: func(m *mocks.UserRemover) {
+				m.On("Delete", mock.Anything, int64(4)).Return(users.ErrLastAdmin)
+			},
+			wantStatus: http.StatusConflict,
+			wantResp:   delete.Response{Err: "the last admin cannot be removed or lose admin rights"},
+		},
+		{
+			name: "unsuccessful deletion - database error",
+			id:   "4",
+			mockSetup: func(m *mocks.UserRemover) {
+				m.On("Delete", mock.Anything, int64(4)).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   delete.Response{Err: "failed to delete user"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewUserRemover(t)
+			tc.mockSetup(mock)
+
+			handler := delete.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/users?id="+tc.id, nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Delete(`/api/users`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp delete.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
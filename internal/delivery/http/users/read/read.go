@@ -0,0 +1,46 @@
+package read
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/render"
+)
+
+const op = "http.Users"
+
+type Response struct {
+	Users []models.User `json:"users,omitempty"`
+	Err   string        `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=UserReader
+type UserReader interface {
+	Users(ctx context.Context) ([]models.User, error)
+}
+
+// @Summary Get users
+// @Description Retrieve all accounts in alphabetical order. Only admins may list the accounts
+// @Produce json
+// @Success 200 {object} Response
+// @Failure 403 {string} string "Admin rights required"
+// @Failure 500 {object} Response "Failed to read users"
+// @Router /api/users [get]
+func New(log *slog.Logger, ur UserReader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		users, err := ur.Users(r.Context())
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			render.JSON(w, r, Response{Err: "failed to read users"})
+			return
+		}
+
+		logger.Info("users were read")
+		render.JSON(w, r, Response{Users: users})
+	}
+}
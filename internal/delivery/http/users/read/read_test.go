@@ -0,0 +1,76 @@
+package read_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/users/read"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/users/read/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUsersHandler(t *testing.T) {
+	stored := []models.User{
+		{ID: 3, Name: "alice", Admin: true, PasswordHash: "$2a$10$alice", CreatedAt: "2025-04-10T10:30:00Z"},
+		{ID: 4, Name: "bob", PasswordHash: "$2a$10$bob", CreatedAt: "2025-04-11T08:00:00Z"},
+	}
+
+	tests := []struct {
+		name       string
+		mockSetup  func(m *mocks.UserReader)
+		wantStatus int
+		wantResp   read.Response
+	}{
+		{
+			name: "successful users reading",
+			mockSetup: func(m *mocks.UserReader) {
+				m.On("Users", mock.Anything).Return(stored, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: read.Response{Users: []models.User{
+				{ID: 3, Name: "alice", Admin: true, CreatedAt: "2025-04-10T10:30:00Z"},
+				{ID: 4, Name: "bob", CreatedAt: "2025-04-11T08:00:00Z"},
+			}},
+		},
+		{
+			name: "unsuccessful users reading - database error",
+			mockSetup: func(m *mocks.UserReader) {
+				m.On("Users", mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   read.Response{Err: "failed to read users"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewUserReader(t)
+			tc.mockSetup(mock)
+
+			handler := read.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users", nil)
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Get(`/api/users`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp read.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package update
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/status"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/validation"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const op = "http.UpdateUser"
+
+type Request struct {
+	ID       int64  `json:"id" validate:"required,min=1"`
+	Password string `json:"password,omitempty" validate:"omitempty,password"`
+	Timezone string `json:"timezone,omitempty" validate:"omitempty,timezone"` // IANA time zone reminders are due in
+	Admin    bool   `json:"admin"`
+}
+
+type Response struct {
+	Err string `json:"error,omitempty"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=UserUpdater
+type UserUpdater interface {
+	Update(ctx context.Context, id int64, password, timezone string, admin bool) error
+}
+
+// @Summary Update a user
+// @Description Grant or withdraw admin rights and, if a password or a time zone is given, replace it for an account.
+// @Description Only admins may update accounts, and the last admin cannot lose the rights
+// @Accept json
+// @Produce json
+// @Param request body Request true "User data"
+// @Success 200 {object} Response
+// @Failure 400 {object} Response "Invalid request format or missing fields"
+// @Failure 403 {string} string "Admin rights required"
+// @Failure 404 {object} Response "User not found"
+// @Failure 409 {object} Response "The last admin cannot lose admin rights"
+// @Failure 500 {object} Response "Failed to update user"
+// @Router /api/users [put]
+func New(log *slog.Logger, uu UserUpdater) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := log.With(slog.String("op", op))
+
+		var req Request
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			logger.Error("failed to decode request body")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: "failed to decode request body"})
+			return
+		}
+
+		v := validator.New()
+		v.RegisterValidation("password", validation.IsPasswordValid)
+		if err := v.Struct(req); err != nil {
+			logger.Error("invalid request")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, Response{Err: validation.ValidationErrorMsg(err.(validator.ValidationErrors))})
+			return
+		}
+
+		err := uu.Update(r.Context(), req.ID, req.Password, req.Timezone, req.Admin)
+		if err != nil {
+			logger.Error(err.Error())
+			w.WriteHeader(status.Of(err))
+			render.JSON(w, r, Response{Err: status.Message(err, "failed to update user")})
+			return
+		}
+
+		logger.Info("user was updated", slog.Int64("id", req.ID))
+		render.JSON(w, r, Response{})
+	}
+}
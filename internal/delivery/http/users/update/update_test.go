@@ -0,0 +1,123 @@
+package update_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/delivery/http/users/update"
+	"github.com/10Narratives/task-tracker/internal/delivery/http/users/update/mocks"
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/services/users"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateUserHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestBody string
+		mockSetup   func(m *mocks.UserUpdater)
+		wantStatus  int
+		wantResp    update.Response
+	}{
+		{
+			name:        "successful update",
+			requestBody: `{"id":4,"password":"battery staple","admin":true}`,
+			mockSetup: func(m *mocks.UserUpdater) {
+				m.On("Update", mock.Anything, int64(4), "battery staple", "", true).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   update.Response{},
+		},
+		{
+			name:        "successful update - password kept",
+			requestBody: `{"id":4}`,
+			mockSetup: func(m *mocks.UserUpdater) {
+				m.On("Update", mock.Anything, int64(4), "", "", false).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   update.Response{},
+		},
+		{
+			name:        "successful update - time zone set",
+			requestBody: `{"id":4,"timezone":"Europe/Berlin"}`,
+			mockSetup: func(m *mocks.UserUpdater) {
+				m.On("Update", mock.Anything, int64(4), "", "Europe/Berlin", false).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   update.Response{},
+		},
+		{
+			name:        "unsuccessful update - unknown time zone",
+			requestBody: `{"id":4,"timezone":"Local"}`,
+			mockSetup:   func(m *mocks.UserUpdater) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    update.Response{Err: "field Timezone must be an IANA time zone name"},
+		},
+		{
+			name:        "unsuccessful update - short password",
+			requestBody: `{"id":4,"password":"staple"}`,
+			mockSetup:   func(m *mocks.UserUpdater) {},
+			wantStatus:  http.StatusBadRequest,
+			wantResp:    update.Response{Err: "field Password must be 8 to 72 bytes long"},
+		},
+		{
+			name:        "unsuccessful update - user not found",
+			requestBody: `{"id":4}`,
+			mockSetup: func(m *mocks.UserUpdater) {
+				m.On("Update", mock.Anything, int64(4), "", "", false).Return(users.ErrUserNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantResp:   update.Response{Err: "user not found"},
+		},
+		{
+			name:        "unsuccessful update - last admin",
+			requestBody: `{"id":4}`,
+			mockSetup: func(m *mocks.UserUpdater) {
+				m.On("Update", mock.Anything, int64(4), "", "", false).Return(users.ErrLastAdmin)
+			},
+			wantStatus: http.StatusConflict,
+			wantResp:   update.Response{Err: "the last admin cannot be removed or lose admin rights"},
+		},
+		{
+			name:        "unsuccessful update - database error",
+			requestBody: `{"id":4}`,
+			mockSetup: func(m *mocks.UserUpdater) {
+				m.On("Update", mock.Anything, int64(4), "", "", false).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResp:   update.Response{Err: "failed to update user"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewUserUpdater(t)
+			tc.mockSetup(mock)
+
+			handler := update.New(slogdiscard.NewDiscardLogger(), mock)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/users", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Put(`/api/users`, handler)
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var actualResp update.Response
+			_ = json.Unmarshal(rec.Body.Bytes(), &actualResp)
+
+			assert.Equal(t, tc.wantResp, actualResp)
+		})
+	}
+}
@@ -2,11 +2,12 @@ package validation
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/10Narratives/task-tracker/internal/lib"
+	"github.com/10Narratives/task-tracker/internal/services/nextdate"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -17,30 +18,76 @@ func IsDateValid(fl validator.FieldLevel) bool {
 	return err == nil
 }
 
+// IsTimeValid checks if time field is in HH:MM format
+func IsTimeValid(fl validator.FieldLevel) bool {
+	clock := fl.Field().String()
+	if clock == "" {
+		// An empty time given on an update drops the time of day.
+		return true
+	}
+	_, err := time.Parse(lib.TimeFormat, clock)
+	return err == nil
+}
+
 // IsTitleValid checks if title is non-empty
 func IsTitleValid(fl validator.FieldLevel) bool {
 	title := fl.Field().String()
 	return len(title) > 0
 }
 
-var (
-	DailyRepeatPattern   string = "^d (?:[1-9]|[1-9][0-9]|[1-3][0-9]{2}|400)$"
-	WeeklyRepeatPattern  string = "^w ([1-7](,[1-7])*)$"
-	MonthlyRepeatPattern string = "^m (-?[1-9]|-1|-2|[12][0-9]|3[01])(,(-?[1-9]|-1|-2|[12][0-9]|3[01]))*( (1[0-2]|[1-9])(,(1[0-2]|[1-9]))*)?$"
-	YearlyRepeatPattern  string = "^y$"
+// MaxTagLength is the maximum number of characters in a tag name.
+const MaxTagLength = 32
+
+// IsTagValid checks if a tag name is non-blank, at most MaxTagLength characters long and free of commas.
+func IsTagValid(fl validator.FieldLevel) bool {
+	tag := strings.TrimSpace(fl.Field().String())
+	return tag != "" && utf8.RuneCountInString(tag) <= MaxTagLength && !strings.Contains(tag, ",")
+}
+
+// MaxUserNameLength is the maximum number of characters in a user name.
+const MaxUserNameLength = 64
+
+// IsUserNameValid checks if a user name is non-blank, at most MaxUserNameLength characters long
+// and free of leading and trailing spaces.
+func IsUserNameValid(fl validator.FieldLevel) bool {
+	name := fl.Field().String()
+	return name != "" && name == strings.TrimSpace(name) && utf8.RuneCountInString(name) <= MaxUserNameLength
+}
+
+// MinPasswordLength and MaxPasswordLength bound the length of passwords in bytes.
+// bcrypt, which passwords are hashed with, does not take more than 72 bytes.
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
 )
 
-// IsRepeatValid checks if the repeat string is either empty or matches the expected patterns.
+// IsPasswordValid checks if a password is between MinPasswordLength and MaxPasswordLength bytes long.
+func IsPasswordValid(fl validator.FieldLevel) bool {
+	password := fl.Field().String()
+	return len(password) >= MinPasswordLength && len(password) <= MaxPasswordLength
+}
+
+// IsRepeatValid checks if the repeat string is either empty or a rule accepted by nextdate.Parse.
 func IsRepeatValid(fl validator.FieldLevel) bool {
 	repeat := fl.Field().String()
 	if repeat == "" {
 		return true
 	}
-	dailyMatch, _ := regexp.MatchString(DailyRepeatPattern, repeat)
-	weeklyMatch, _ := regexp.MatchString(WeeklyRepeatPattern, repeat)
-	monthlyMatch, _ := regexp.MatchString(MonthlyRepeatPattern, repeat)
-	yearlyMatch, _ := regexp.MatchString(YearlyRepeatPattern, repeat)
-	return dailyMatch || weeklyMatch || monthlyMatch || yearlyMatch
+	_, err := nextdate.Parse(repeat)
+	return err == nil
+}
+
+// NormalizeRepeat converts an RFC 5545 RRULE repeat value into the native repeat rule syntax.
+// Native rules are returned unchanged and are checked later by IsRepeatValid.
+func NormalizeRepeat(repeat string) (string, error) {
+	if !nextdate.IsRRULE(repeat) {
+		return repeat, nil
+	}
+	rule, err := nextdate.FromRRULE(repeat)
+	if err != nil {
+		return "", err
+	}
+	return rule.String(), nil
 }
 
 func ValidationErrorMsg(errs validator.ValidationErrors) string {
@@ -48,14 +95,34 @@ func ValidationErrorMsg(errs validator.ValidationErrors) string {
 
 	for _, err := range errs {
 		switch err.ActualTag() {
-		case "required":
+		case "required", "required_if":
 			errMsgs = append(errMsgs, fmt.Sprintf("field %s is required", err.Field()))
 		case "dateformat":
 			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be in YYYYMMDD date format", err.Field()))
+		case "timeofday":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be a time in HH:MM format", err.Field()))
 		case "title":
 			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be non-empty", err.Field()))
 		case "repeat":
 			errMsgs = append(errMsgs, fmt.Sprintf("field %s must satisfy expected patterns", err.Field()))
+		case "tag":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be a non-empty name of at most %d characters without commas", err.Field(), MaxTagLength))
+		case "username":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be a non-blank name of at most %d characters without surrounding spaces", err.Field(), MaxUserNameLength))
+		case "password":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be %d to %d bytes long", err.Field(), MinPasswordLength, MaxPasswordLength))
+		case "timezone":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be an IANA time zone name", err.Field()))
+		case "hexcolor":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be a colour in #RRGGBB format", err.Field()))
+		case "min":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be at least %s", err.Field(), err.Param()))
+		case "max":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be at most %s", err.Field(), err.Param()))
+		case "unique":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must not contain duplicates", err.Field()))
+		case "oneof":
+			errMsgs = append(errMsgs, fmt.Sprintf("field %s must be one of: %s", err.Field(), strings.ReplaceAll(err.Param(), " ", ", ")))
 		default:
 			errMsgs = append(errMsgs, fmt.Sprintf("field %s is invalid", err.Field()))
 		}
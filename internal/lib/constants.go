@@ -3,4 +3,5 @@ package lib
 const (
 	DateFormat       string = `20060102`
 	SearchDateFormat string = `02.01.2006`
+	TimeFormat       string = `15:04`
 )
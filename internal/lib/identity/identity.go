@@ -0,0 +1,24 @@
+package identity
+
+import "context"
+
+// User is the account a request is made on behalf of.
+type User struct {
+	ID    int64  // ID of the account, which owns the tasks created on its behalf
+	Name  string // Name the user signs in with and is known by in the audit log
+	Admin bool   // Whether the user may manage the application, such as read the audit log
+}
+
+type contextKey struct{}
+
+// WithUser returns a copy of ctx carrying the user the request is made on behalf of.
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, contextKey{}, user)
+}
+
+// FromContext returns the user stored in ctx by WithUser.
+// The second result is false if ctx carries no user.
+func FromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(contextKey{}).(User)
+	return user, ok
+}
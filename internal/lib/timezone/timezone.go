@@ -0,0 +1,20 @@
+package timezone
+
+import (
+	"context"
+	"time"
+)
+
+type contextKey struct{}
+
+// WithLocation returns a copy of ctx carrying the time zone of the current user.
+func WithLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, contextKey{}, loc)
+}
+
+// FromContext returns the time zone stored in ctx by WithLocation.
+// The second result is false if ctx carries no time zone.
+func FromContext(ctx context.Context) (*time.Location, bool) {
+	loc, ok := ctx.Value(contextKey{}).(*time.Location)
+	return loc, ok && loc != nil
+}
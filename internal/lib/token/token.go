@@ -0,0 +1,62 @@
+package token
+
+import (
+	"crypto/rand"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalid is returned for tokens which are malformed, expired or not signed by the issuer.
+var ErrInvalid = errors.New("invalid or expired token")
+
+// Issuer signs the tokens which tell on behalf of which account a request is made and checks the tokens it signed.
+type Issuer struct {
+	secret []byte        // HMAC key the tokens are signed with
+	ttl    time.Duration // How long a token stays valid
+}
+
+// New creates an Issuer signing tokens with the secret which stay valid for ttl.
+func New(secret []byte, ttl time.Duration) Issuer {
+	return Issuer{secret: secret, ttl: ttl}
+}
+
+// RandomSecret returns a new random key. Tokens signed with it stop being valid when the application restarts.
+func RandomSecret() ([]byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// Issue returns a token for the account with the ID.
+func (issuer Issuer) Issue(userID int64) (string, error) {
+	now := time.Now()
+	claims := jwt.RegisteredClaims{
+		Subject:   strconv.FormatInt(userID, 10),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(issuer.ttl)),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(issuer.secret)
+}
+
+// Parse checks a token signed by Issue and returns the ID of the account it was issued for.
+// It returns ErrInvalid if the token cannot be trusted or has expired.
+func (issuer Issuer) Parse(token string) (int64, error) {
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return issuer.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return 0, ErrInvalid
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return 0, ErrInvalid
+	}
+	return userID, nil
+}
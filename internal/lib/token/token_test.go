@@ -0,0 +1,77 @@
+package token_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib/token"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssuer(t *testing.T) {
+	t.Parallel()
+
+	issuer := token.New([]byte("secret"), time.Hour)
+
+	withoutExpiry, err := jwt.New(jwt.SigningMethodHS256).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	expired, err := token.New([]byte("secret"), -time.Minute).Issue(3)
+	require.NoError(t, err)
+	foreign, err := token.New([]byte("other secret"), time.Hour).Issue(3)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		token   func() string
+		wantID  int64
+		wantErr error
+	}{
+		{
+			name: "token issued for a user",
+			token: func() string {
+				tok, err := issuer.Issue(3)
+				require.NoError(t, err)
+				return tok
+			},
+			wantID: 3,
+		},
+		{
+			name:    "expired token",
+			token:   func() string { return expired },
+			wantErr: token.ErrInvalid,
+		},
+		{
+			name:    "token signed with another secret",
+			token:   func() string { return foreign },
+			wantErr: token.ErrInvalid,
+		},
+		{
+			name:    "token without expiry",
+			token:   func() string { return withoutExpiry },
+			wantErr: token.ErrInvalid,
+		},
+		{
+			name:    "malformed token",
+			token:   func() string { return "not a token" },
+			wantErr: token.ErrInvalid,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := issuer.Parse(tc.token())
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantID, id)
+		})
+	}
+}
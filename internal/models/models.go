@@ -1,9 +1,191 @@
 package models
 
+// Recurrence anchor modes of a task.
+const (
+	AnchorDue        = "due"        // The next date is counted from the scheduled date
+	AnchorCompletion = "completion" // The next date is counted from the day the task was completed
+)
+
+// Task priorities, 1 is the most urgent.
+const (
+	PriorityUrgent = 1
+	PriorityHigh   = 2
+	PriorityNormal = 3
+	PriorityLow    = 4
+)
+
+// Workflow statuses of a task.
+const (
+	StatusTodo       = "todo"
+	StatusInProgress = "in_progress"
+	StatusBlocked    = "blocked"
+	StatusDone       = "done"
+)
+
 type Task struct {
-	ID      int64  `json:"id"`
-	Date    string `json:"date"`
-	Title   string `json:"title"`
-	Comment string `json:"comment"`
-	Repeat  string `json:"repeat"`
+	ID         int64    `json:"id"`
+	Date       string   `json:"date"`
+	Time       string   `json:"time,omitempty"` // Time of day the task is due at in HH:MM format, empty if it is due at the start of its day
+	Title      string   `json:"title"`
+	Comment    string   `json:"comment"`
+	Repeat     string   `json:"repeat"`
+	Anchor     string   `json:"anchor"`               // AnchorDue or AnchorCompletion
+	ExDates    []string `json:"exdates,omitempty"`    // Dates in YYYYMMDD format on which a recurring task does not occur
+	Occurrence int      `json:"occurrence"`           // 1-based number of the current occurrence of a recurring task
+	Priority   int      `json:"priority"`             // Priority from 1 (urgent) to 4 (low)
+	Status     string   `json:"status"`               // Workflow status: todo, in_progress, blocked or done
+	ProjectID  int64    `json:"project_id,omitempty"` // Project the task belongs to, 0 if none
+	ParentID   int64    `json:"parent_id,omitempty"`  // Task this one is a subtask of, 0 for top-level tasks
+	Reminders  []int    `json:"reminders,omitempty"`  // Minutes before the due time at which to remind about the task
+	Version    int64    `json:"version,omitempty"`    // Number of the revision of the task, bumped by every update
+	Tags       []string `json:"tags,omitempty"`       // Names of the tags attached to the task in alphabetical order
+	DeletedAt  string   `json:"deleted_at,omitempty"` // Time the task was moved to the trash in RFC 3339 format, UTC
+	Children   []Task   `json:"children,omitempty"`   // Subtasks of a top-level task, filled in when a single task is read
+	BlockedBy  []int64  `json:"blocked_by,omitempty"` // IDs of the tasks which have to be done before this one
+	Blocking   []int64  `json:"blocking,omitempty"`   // IDs of the tasks waiting for this one to be done
+
+	OwnerTimezone string `json:"-"` // Time zone of the owner of the task, filled in when the tasks with reminders are read
+	ClearTime     bool   `json:"-"` // Whether an update drops the time of day, which is kept when Time is left empty otherwise
+}
+
+// Reminder is a notice about a task which is due soon.
+type Reminder struct {
+	TaskID   int64  `json:"task_id"`
+	Title    string `json:"title"`
+	Offset   int    `json:"offset"`    // Minutes between the reminder and the due time
+	DueAt    string `json:"due_at"`    // Time the task is due at in RFC 3339 format, UTC
+	RemindAt string `json:"remind_at"` // Time of the reminder in RFC 3339 format, UTC
+}
+
+// Orders in which tasks can be listed. Ties are broken by date.
+const (
+	SortByDate     = "date"
+	SortByPriority = "priority"
+	SortByStatus   = "status"
+)
+
+// TaskFilter narrows down and orders a task listing. Zero fields do not restrict the selection.
+type TaskFilter struct {
+	Priority  int    // Only tasks with this priority
+	Status    string // Only tasks with this status
+	ProjectID int64  // Only tasks of the project with this ID
+	Sort      string // Order of the tasks, by date if empty
+
+	Tags    []string // Only tasks with any of these tags
+	AllTags bool     // Only tasks with all of the tags instead of any of them
+}
+
+// Tag is a label grouping tasks by context, such as home or work.
+type Tag struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Tasks int    `json:"tasks"` // Number of tasks outside the trash with the tag
+}
+
+// User is an account which owns tasks. Its password is only kept as a hash.
+type User struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`               // Name the user signs in with
+	Admin        bool   `json:"admin"`              // Whether the user may manage accounts and read the audit log
+	Timezone     string `json:"timezone,omitempty"` // IANA time zone the user's tasks are due in, the default one if empty
+	PasswordHash string `json:"-"`                  // bcrypt hash of the password
+	CreatedAt    string `json:"created_at"`         // Time the account was created in RFC 3339 format, UTC
+}
+
+// Project is a separate list of tasks.
+type Project struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Color    string `json:"color,omitempty"` // Colour of the project in #RRGGBB format
+	Archived bool   `json:"archived"`        // Archived projects keep their tasks but take no new ones
+}
+
+// Completion records that an occurrence of a task was done, or missed and skipped by the rollover.
+type Completion struct {
+	ID          int64  `json:"id"`
+	TaskID      int64  `json:"task_id"`
+	Title       string `json:"title"`
+	Date        string `json:"date"`              // Scheduled date of the completed occurrence in YYYYMMDD format
+	CompletedAt string `json:"completed_at"`      // Time of completion or of the rollover in RFC 3339 format, UTC
+	Skipped     bool   `json:"skipped,omitempty"` // Whether the occurrence was missed and rolled over instead of completed
+}
+
+// CompletionFilter selects completions. Zero fields do not restrict the selection.
+type CompletionFilter struct {
+	TaskID int64  // Only completions of the task with this ID
+	From   string // Only completions at or after this RFC 3339 time, UTC
+	To     string // Only completions before this RFC 3339 time, UTC
+}
+
+// Kinds of operations which can be undone.
+const (
+	OperationRegister = "register" // A task was created
+	OperationUpdate   = "update"   // A task was modified
+	OperationDelete   = "delete"   // A task was moved to the trash
+	OperationComplete = "complete" // A task was completed
+)
+
+// Kinds of changes which are written to the audit log but cannot be undone.
+const (
+	OperationRestore  = "restore"  // A task was taken out of the trash
+	OperationSkip     = "skip"     // An occurrence of a recurring task was skipped
+	OperationUndo     = "undo"     // An operation on a task was undone
+	OperationRollover = "rollover" // An overdue recurring task was moved on by the rollover job
+)
+
+// Actions which can be applied to tasks in a batch.
+const (
+	BatchComplete = "complete"  // The task is completed
+	BatchDelete   = "delete"    // The task is moved to the trash
+	BatchUpdate   = "update"    // The task is replaced with new details
+	BatchMoveDate = "move_date" // The task is moved to another date
+)
+
+// BatchOperation is a single action applied to a task as part of a batch.
+type BatchOperation struct {
+	Action  string // One of the batch actions
+	ID      int64  // ID of the task the action applies to
+	Task    Task   // New details of the task for updates
+	Date    string // New date of the task in YYYYMMDD format for date moves
+	Force   bool   // Whether a task is completed even if it is blocked
+	Version int64  // Version of the task the operation is based on, 0 for any version
+}
+
+// BatchResult is the outcome of a single operation of a batch.
+type BatchResult struct {
+	ID     int64  `json:"id"`
+	Action string `json:"action"`
+	Err    string `json:"error,omitempty"` // Why the operation failed, empty if it succeeded
+}
+
+// Operation records a change to a task together with what is needed to revert it.
+type Operation struct {
+	ID           int64  `json:"id"`
+	Kind         string `json:"kind"`
+	TaskID       int64  `json:"task_id"`
+	Task         *Task  `json:"task,omitempty"`          // Task as it was before the operation, nil for registrations
+	CompletionID int64  `json:"completion_id,omitempty"` // Completion recorded by a complete operation
+	CreatedAt    string `json:"created_at"`              // Time of the operation in RFC 3339 format, UTC
+}
+
+// AuditEntry records who changed a task, when and how. The audit log is append-only.
+type AuditEntry struct {
+	ID        int64  `json:"id"`
+	Actor     string `json:"actor"`            // Name of the user who made the change
+	Operation string `json:"operation"`        // One of the operation kinds: register, update, delete, complete, restore, skip, undo or rollover
+	TaskID    int64  `json:"task_id"`          // Task the change was made to
+	Before    *Task  `json:"before,omitempty"` // Task before the change, nil for registrations
+	After     *Task  `json:"after,omitempty"`  // Task after the change, nil if the change moved it to the trash
+	CreatedAt string `json:"created_at"`       // Time of the change in RFC 3339 format, UTC
+}
+
+// AuditFilter selects a page of the audit log. Zero fields do not restrict the selection.
+type AuditFilter struct {
+	Actor     string // Only changes made by this user
+	Operation string // Only changes of this kind
+	TaskID    int64  // Only changes to the task with this ID
+	From      string // Only changes made at or after this RFC 3339 time, UTC
+	To        string // Only changes made before this RFC 3339 time, UTC
+	Limit     int    // Maximum number of entries on the page, the storage limit if zero
+	Offset    int    // Number of entries skipped before the page
 }
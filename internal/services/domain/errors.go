@@ -0,0 +1,51 @@
+// Package domain defines the kinds of errors the services report, so that callers can tell
+// a missing record or a broken rule from a failure without knowing every error of every service.
+package domain
+
+import "errors"
+
+// Kinds of domain errors. Errors of a kind match it with errors.Is.
+var (
+	// ErrNotFound is the kind of errors returned when a record does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is the kind of errors returned when a change clashes with the current state of the records.
+	ErrConflict = errors.New("conflict")
+	// ErrInvalidRule is the kind of errors returned when a change breaks a rule of the domain,
+	// such as a repeat rule which cannot be parsed or a subtask which is given one.
+	ErrInvalidRule = errors.New("invalid rule")
+	// ErrPrecondition is the kind of errors returned when a change is based on an outdated version of a record.
+	ErrPrecondition = errors.New("precondition failed")
+)
+
+// Error is an error of one of the kinds with its own message.
+type Error struct {
+	kind  error
+	msg   string
+	cause error
+}
+
+// New creates an error of the kind with the given message.
+func New(kind error, msg string) error {
+	return &Error{kind: kind, msg: msg}
+}
+
+// Wrap marks err as an error of the kind keeping its message. It returns nil if err is nil.
+func Wrap(kind, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{kind: kind, msg: err.Error(), cause: err}
+}
+
+// Error returns the message of the error.
+func (e *Error) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the kind of the error and the error it wraps, if any.
+func (e *Error) Unwrap() []error {
+	if e.cause == nil {
+		return []error{e.kind}
+	}
+	return []error{e.kind, e.cause}
+}
@@ -0,0 +1,34 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	err := domain.New(domain.ErrNotFound, "task 7 not found")
+	wrapped := fmt.Errorf("cannot complete task: %w", err)
+
+	assert.EqualError(t, err, "task 7 not found")
+	assert.ErrorIs(t, wrapped, domain.ErrNotFound)
+	assert.ErrorIs(t, wrapped, err)
+	assert.False(t, errors.Is(wrapped, domain.ErrConflict))
+}
+
+func TestWrap(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("invalid repeat rule")
+	err := domain.Wrap(domain.ErrInvalidRule, cause)
+
+	assert.EqualError(t, err, "invalid repeat rule")
+	assert.ErrorIs(t, err, domain.ErrInvalidRule)
+	assert.ErrorIs(t, err, cause)
+	assert.NoError(t, domain.Wrap(domain.ErrInvalidRule, nil))
+}
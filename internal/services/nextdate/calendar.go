@@ -0,0 +1,209 @@
+package nextdate
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib"
+	"gopkg.in/yaml.v3"
+)
+
+// Calendar tells which dates are public holidays.
+// Saturdays and Sundays are days off regardless of the calendar.
+type Calendar interface {
+	IsHoliday(date time.Time) bool
+}
+
+// isWorkday reports whether date is neither a weekend nor a holiday of cal.
+func isWorkday(date time.Time, cal Calendar) bool {
+	if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+		return false
+	}
+	return cal == nil || !cal.IsHoliday(date)
+}
+
+// addWorkdays moves date forward by n working days.
+func addWorkdays(date time.Time, n int, cal Calendar) time.Time {
+	for n > 0 {
+		date = date.AddDate(0, 0, 1)
+		if isWorkday(date, cal) {
+			n--
+		}
+	}
+	return date
+}
+
+// toWorkday returns date itself if it is a working day,
+// otherwise the closest working day in the given direction.
+func toWorkday(date time.Time, direction Shift, cal Calendar) time.Time {
+	for !isWorkday(date, cal) {
+		date = date.AddDate(0, 0, int(direction))
+	}
+	return date
+}
+
+// workdaysOfMonth returns the sorted days of the month which are the listed working days.
+// Negative positions count from the end of the month. Positions past the number of
+// working days in the month are dropped.
+func workdaysOfMonth(positions []int, month time.Month, year int, cal Calendar) []int {
+	var workdays []int
+	for day := 1; day <= daysInMonth(month, year); day++ {
+		if isWorkday(time.Date(year, month, day, 0, 0, 0, 0, time.UTC), cal) {
+			workdays = append(workdays, day)
+		}
+	}
+
+	days := make([]int, 0, len(positions))
+	for _, position := range positions {
+		index := position - 1
+		if position < 0 {
+			index = len(workdays) + position
+		}
+		if index >= 0 && index < len(workdays) {
+			days = append(days, workdays[index])
+		}
+	}
+	sort.Ints(days)
+	return slices.Compact(days)
+}
+
+// Holidays is a set of holiday dates.
+type Holidays map[string]struct{}
+
+// IsHoliday implements Calendar.
+func (h Holidays) IsHoliday(date time.Time) bool {
+	_, ok := h[date.Format(lib.DateFormat)]
+	return ok
+}
+
+// Add marks date as a holiday.
+func (h Holidays) Add(date time.Time) {
+	h[date.Format(lib.DateFormat)] = struct{}{}
+}
+
+// LoadHolidays reads a holiday calendar from an iCalendar (.ics) or YAML (.yaml, .yml) file.
+//
+// A YAML calendar lists dates in YYYYMMDD or YYYY-MM-DD format:
+//
+//	holidays:
+//	  - 2025-01-01
+//	  - 20250107
+//
+// In an iCalendar file every VEVENT marks the days from DTSTART up to,
+// but not including, DTEND as holidays.
+func LoadHolidays(path string) (Holidays, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open holiday calendar: %w", err)
+	}
+	defer file.Close()
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".ics":
+		return parseICS(file)
+	case ".yaml", ".yml":
+		return parseHolidaysYAML(file)
+	default:
+		return nil, fmt.Errorf("unsupported holiday calendar format %q", ext)
+	}
+}
+
+func parseHolidaysYAML(file *os.File) (Holidays, error) {
+	var doc struct {
+		Holidays []string `yaml:"holidays"`
+	}
+	if err := yaml.NewDecoder(file).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("cannot decode holiday calendar: %w", err)
+	}
+
+	holidays := make(Holidays, len(doc.Holidays))
+	for _, value := range doc.Holidays {
+		date, err := time.Parse(lib.DateFormat, strings.ReplaceAll(value, "-", ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday %q: %w", value, err)
+		}
+		holidays.Add(date)
+	}
+	return holidays, nil
+}
+
+func parseICS(file *os.File) (Holidays, error) {
+	holidays := make(Holidays)
+
+	var (
+		lines      []string
+		scanner    = bufio.NewScanner(file)
+		start, end time.Time
+		inEvent    bool
+	)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		// Lines starting with a space or a tab continue the previous line.
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read holiday calendar: %w", err)
+	}
+
+	for _, line := range lines {
+		name, value, _ := strings.Cut(line, ":")
+		name, _, _ = strings.Cut(name, ";")
+
+		var err error
+		switch strings.ToUpper(name) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VEVENT") {
+				inEvent, start, end = true, time.Time{}, time.Time{}
+			}
+		case "DTSTART":
+			if inEvent {
+				start, err = parseICSDate(value)
+			}
+		case "DTEND":
+			if inEvent {
+				end, err = parseICSDate(value)
+			}
+		case "END":
+			if !inEvent || !strings.EqualFold(value, "VEVENT") {
+				continue
+			}
+			inEvent = false
+			if start.IsZero() {
+				return nil, fmt.Errorf("holiday event without DTSTART")
+			}
+			if !end.After(start) {
+				end = start.AddDate(0, 0, 1)
+			}
+			for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+				holidays.Add(day)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return holidays, nil
+}
+
+// parseICSDate reads the date part of an iCalendar DATE or DATE-TIME value.
+func parseICSDate(value string) (time.Time, error) {
+	if len(value) < len(lib.DateFormat) {
+		return time.Time{}, fmt.Errorf("invalid holiday date %q", value)
+	}
+	date, err := time.Parse(lib.DateFormat, value[:len(lib.DateFormat)])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid holiday date %q: %w", value, err)
+	}
+	return date, nil
+}
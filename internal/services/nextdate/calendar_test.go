@@ -0,0 +1,90 @@
+package nextdate_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/services/nextdate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadHolidays(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		holidays []string
+		workdays []string
+		wantErr  string
+	}{
+		{
+			name: "yaml calendar",
+			file: "holidays.yaml",
+			content: `holidays:
+  - 2025-01-01
+  - "20250107"
+`,
+			holidays: []string{"20250101", "20250107"},
+			workdays: []string{"20250102"},
+		},
+		{
+			name: "ics calendar",
+			file: "holidays.ics",
+			content: "BEGIN:VCALENDAR\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART;VALUE=DATE:20250101\r\n" +
+				"SUMMARY:New Year\r\n" +
+				"END:VEVENT\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"DTSTART;VALUE=DATE:20250501\r\n" +
+				"DTEND;VALUE=DATE:20250503\r\n" +
+				"SUMMARY:Long\r\n" +
+				" weekend\r\n" +
+				"END:VEVENT\r\n" +
+				"END:VCALENDAR\r\n",
+			holidays: []string{"20250101", "20250501", "20250502"},
+			workdays: []string{"20250102", "20250503"},
+		},
+		{
+			name:    "invalid yaml date",
+			file:    "holidays.yml",
+			content: "holidays:\n  - 01.01.2025\n",
+			wantErr: `invalid holiday "01.01.2025"`,
+		},
+		{
+			name:    "unsupported format",
+			file:    "holidays.txt",
+			content: "20250101",
+			wantErr: `unsupported holiday calendar format ".txt"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), tc.file)
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			holidays, err := nextdate.LoadHolidays(path)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, day := range tc.holidays {
+				date, _ := time.Parse("20060102", day)
+				assert.True(t, holidays.IsHoliday(date), day)
+			}
+			for _, day := range tc.workdays {
+				date, _ := time.Parse("20060102", day)
+				assert.False(t, holidays.IsHoliday(date), day)
+			}
+		})
+	}
+}
@@ -2,8 +2,6 @@ package nextdate
 
 import (
 	"sort"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/10Narratives/task-tracker/internal/lib"
@@ -27,35 +25,86 @@ func shiftYearly(now, date time.Time) time.Time {
 	return date.AddDate(yearDiff+1, 0, 0)
 }
 
+// shiftWeekly returns the first listed weekday after base, numbered from 1 (Monday) to 7 (Sunday).
+// A later weekday of the same week is returned as such.
 func shiftWeekly(base time.Time, weekdays []int) time.Time {
-	baseWeekday := int(base.Weekday())
-	if baseWeekday == 0 {
-		baseWeekday = 7
-	}
+	baseWeekday := isoWeekday(base)
 	for _, day := range weekdays {
 		if day > baseWeekday {
-			return base.AddDate(0, 0, 7-baseWeekday)
+			return base.AddDate(0, 0, day-baseWeekday)
 		}
 	}
 	return base.AddDate(0, 0, 7+weekdays[0]-baseWeekday)
 }
 
+// shiftIntervalWeekly finds the first listed weekday after base which falls into a week
+// a whole number of intervals away from the week of anchor.
+func shiftIntervalWeekly(base, anchor time.Time, weekdays []int, interval int) time.Time {
+	next := shiftWeekly(base, weekdays)
+	if interval <= 1 {
+		return next
+	}
+
+	anchorWeek := startOfWeek(anchor)
+	for {
+		weeks := int(startOfWeek(next).Sub(anchorWeek).Hours()/24) / 7
+		if (weeks%interval+interval)%interval == 0 {
+			return next
+		}
+		next = shiftWeekly(next, weekdays)
+	}
+}
+
+// startOfWeek returns the Monday of the week t belongs to.
+func startOfWeek(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day-isoWeekday(t)+1, 0, 0, 0, 0, time.UTC)
+}
+
 func daysInMonth(month time.Month, year int) int {
 	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
 }
 
-func normalizeDays(days []int, month time.Month, year int) []int {
+// isoWeekday returns the weekday of t numbered from 1 (Monday) to 7 (Sunday).
+func isoWeekday(t time.Time) int {
+	weekday := int(t.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	return weekday
+}
+
+// maxMonthlySearch bounds the search for a matching month.
+// 28 years cover every combination of leap years and weekdays.
+const maxMonthlySearch = 28 * 12
+
+// normalizeDays returns the sorted days of the month matched by the listed days and ordinal weekdays.
+// Days which do not exist in the month are dropped.
+func normalizeDays(days []int, weekdays []OrdinalWeekday, month time.Month, year int) []int {
 	totalDays := daysInMonth(month, year)
-	for i := range len(days) {
-		if days[i] == -1 || days[i] == -2 {
-			days[i] += totalDays + 1
+	monthDays := make([]int, 0, len(days)+len(weekdays))
+	for _, day := range days {
+		if day == -1 || day == -2 {
+			day += totalDays + 1
+		}
+		if day <= totalDays {
+			monthDays = append(monthDays, day)
 		}
 	}
-	sort.Ints(days)
-	return days
+	for _, weekday := range weekdays {
+		if day, ok := weekday.dayIn(month, year); ok {
+			monthDays = append(monthDays, day)
+		}
+	}
+	sort.Ints(monthDays)
+	return monthDays
 }
 
-func shiftMonthly(now time.Time, days []int, months []int) time.Time {
+// shiftMonthly returns the first matching day after now.
+// daysOf lists the sorted matching days of a month, months limits the search to the listed months.
+// The search moves from December on to January of the next year.
+// It returns the zero time if no matching day exists.
+func shiftMonthly(now time.Time, months []int, daysOf func(month time.Month, year int) []int) time.Time {
 	currYear, currMonth, currDay := now.Date()
 
 	allowedMonths := make(map[int]bool)
@@ -63,70 +112,110 @@ func shiftMonthly(now time.Time, days []int, months []int) time.Time {
 		allowedMonths[m] = true
 	}
 
-	for {
-		if len(allowedMonths) > 0 && !allowedMonths[int(currMonth)] {
-			currMonth++
-			if currMonth > 12 {
-				currMonth = 1
-				currYear++
+	for range maxMonthlySearch {
+		if len(allowedMonths) == 0 || allowedMonths[int(currMonth)] {
+			monthDays := daysOf(currMonth, currYear)
+			index := sort.Search(len(monthDays), func(i int) bool { return monthDays[i] > currDay })
+			if index != len(monthDays) {
+				return time.Date(currYear, currMonth, monthDays[index], 0, 0, 0, 0, time.UTC)
 			}
-			currDay = 0
-			continue
-		}
-
-		monthDays := make([]int, len(days))
-		copy(monthDays, days)
-		monthDays = normalizeDays(monthDays, currMonth, currYear)
-		index := sort.Search(len(days), func(i int) bool { return monthDays[i] > currDay })
-		if index != len(days) {
-			return time.Date(currYear, currMonth, monthDays[index], 0, 0, 0, 0, time.UTC)
 		}
 
 		currMonth++
-		if currMonth == 12 {
+		if currMonth > 12 {
 			currMonth = 1
 			currYear++
 		}
 		currDay = 0
 	}
+
+	return time.Time{}
 }
 
-// NextDate calculates the next occurrence of a date based on a given repetition pattern.
-func NextDate(now, date time.Time, repeat string) string {
-	nextdate := ""
-	switch repeat[0] {
-	case 'd':
-		days, _ := strconv.Atoi(repeat[2:])
-		nextdate = shiftDaily(now, date, days).Format(lib.DateFormat)
-	case 'y':
-		nextdate = shiftYearly(now, date).Format(lib.DateFormat)
-	case 'w':
-		weekdays := strings.Split(repeat[2:], ",")
-		days := make([]int, len(weekdays))
-		for i, weekday := range weekdays {
-			days[i], _ = strconv.Atoi(weekday)
+// Option configures the calculation of next dates.
+type Option func(*options)
+
+type options struct {
+	calendar Calendar
+	excluded map[string]struct{}
+}
+
+// WithCalendar sets the holiday calendar used by working day rules and shift modifiers.
+// Without a calendar only weekends are treated as days off.
+func WithCalendar(cal Calendar) Option {
+	return func(o *options) {
+		o.calendar = cal
+	}
+}
+
+// WithExcluded sets exception dates which are jumped over as if the rule never produced them.
+func WithExcluded(dates ...time.Time) Option {
+	return func(o *options) {
+		if o.excluded == nil {
+			o.excluded = make(map[string]struct{}, len(dates))
 		}
-		sort.Ints(days)
-		nextdate = shiftWeekly(now, days).Format(lib.DateFormat)
-	case 'm':
-		strs := strings.Split(repeat, " ")
-
-		strDays := strings.Split(strs[1], ",")
-		days := make([]int, len(strDays))
-		for i, strDay := range strDays {
-			days[i], _ = strconv.Atoi(strDay)
+		for _, date := range dates {
+			o.excluded[date.Format(lib.DateFormat)] = struct{}{}
 		}
-		sort.Ints(days)
-
-		months := make([]int, 0)
-		if len(strs) > 2 {
-			strMonths := strings.Split(strs[2], ",")
-			for _, strMonth := range strMonths {
-				month, _ := strconv.Atoi(strMonth)
-				months = append(months, month)
-			}
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func (o options) isExcluded(date time.Time) bool {
+	_, ok := o.excluded[date.Format(lib.DateFormat)]
+	return ok
+}
+
+// Next returns the first occurrence of the rule after now for a task scheduled on date,
+// skipping the excluded dates. It returns the zero time if the rule has no further occurrences.
+func Next(rule Rule, now, date time.Time, opts ...Option) time.Time {
+	o := newOptions(opts)
+	next := rule.Next(now, date, o.calendar)
+	for !next.IsZero() && o.isExcluded(next) {
+		next = rule.Next(next, date, o.calendar)
+	}
+	return next
+}
+
+// NextDate calculates the next occurrence of a date based on a given repetition pattern.
+// It returns an empty string if the until modifier of the pattern allows no further occurrences
+// and a *ParseError if the pattern is not a valid repeat rule.
+func NextDate(now, date time.Time, repeat string, opts ...Option) (string, error) {
+	rule, err := Parse(repeat)
+	if err != nil {
+		return "", err
+	}
+	next := Next(rule, now, date, opts...)
+	if next.IsZero() {
+		return "", nil
+	}
+	return next.Format(lib.DateFormat), nil
+}
+
+// Occurrences returns up to n consecutive occurrences of the rule following start,
+// which is itself counted as the first occurrence of the rule. Excluded dates are left out.
+// The list is shorter than n only if the rule runs out of occurrences.
+func Occurrences(start time.Time, rule Rule, n int, opts ...Option) []time.Time {
+	o := newOptions(opts)
+	dates := make([]time.Time, 0, max(n, 0))
+	current := start
+	for len(dates) < n && !Exhausted(rule, len(dates)+1) {
+		next := rule.Next(current, current, o.calendar)
+		if next.IsZero() || !next.After(current) {
+			break
+		}
+		current = next
+		if o.isExcluded(next) {
+			continue
 		}
-		nextdate = shiftMonthly(now, days, months).Format(lib.DateFormat)
+		dates = append(dates, next)
 	}
-	return nextdate
+	return dates
 }
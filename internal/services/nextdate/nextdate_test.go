@@ -6,6 +6,7 @@ import (
 
 	"github.com/10Narratives/task-tracker/internal/services/nextdate"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNextDate(t *testing.T) {
@@ -90,6 +91,71 @@ func TestNextDate(t *testing.T) {
 			args: args{now: time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2023, 1, 26, 0, 0, 0, 0, time.UTC), repeat: "w 4,5"},
 			want: "20240201",
 		},
+		{
+			name: "successful weekly move - later day in the same week",
+			args: args{now: time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC), repeat: "w 3,5"},
+			want: "20240124",
+		},
+		{
+			name: "successful weekly move - next day, not sunday",
+			args: args{now: time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC), repeat: "w 2"},
+			want: "20240123",
+		},
+		{
+			name: "successful weekly move - saturday after thursday",
+			args: args{now: time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC), repeat: "w 3,6"},
+			want: "20240127",
+		},
+		{
+			name: "w 1,4 2 - later day in the anchor week",
+			args: args{now: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "w 1,4 2"},
+			want: "20250206",
+		},
+		{
+			name: "w 1,4 2 - odd week is skipped",
+			args: args{now: time.Date(2025, 2, 6, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "w 1,4 2"},
+			want: "20250217",
+		},
+		{
+			name: "w 1 2 - now in the skipped week",
+			args: args{now: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "w 1 2"},
+			want: "20250217",
+		},
+		{
+			name: "w 1 2 - same day",
+			args: args{now: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "w 1 2"},
+			want: "20250217",
+		},
+		{
+			name: "w 7 2 - sunday ends the anchor week",
+			args: args{now: time.Date(2025, 2, 9, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 9, 0, 0, 0, 0, time.UTC), repeat: "w 7 2"},
+			want: "20250223",
+		},
+		{
+			name: "w 5 3 - several periods have passed",
+			args: args{now: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "w 5 3"},
+			want: "20250321",
+		},
+		{
+			name: "w 1 2 - anchor after now",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 17, 0, 0, 0, 0, time.UTC), repeat: "w 1 2"},
+			want: "20250217",
+		},
+		{
+			name: "w 3 2 - across the year boundary",
+			args: args{now: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), repeat: "w 3 2"},
+			want: "20250108",
+		},
+		{
+			name: "w 2,4 4 - four week interval",
+			args: args{now: time.Date(2025, 2, 6, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 4, 0, 0, 0, 0, time.UTC), repeat: "w 2,4 4"},
+			want: "20250304",
+		},
+		{
+			name: "w 1 1 - interval of one is every week",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "w 1 1"},
+			want: "20250210",
+		},
 		{
 			name: "m 13",
 			args: args{now: time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2023, 11, 6, 0, 0, 0, 0, time.UTC), repeat: "m 13"},
@@ -140,11 +206,71 @@ func TestNextDate(t *testing.T) {
 			args: args{now: time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 3, 26, 0, 0, 0, 0, time.UTC), repeat: "m -1,-2"},
 			want: "20240130",
 		}, //
+		{
+			name: "m 5 - december is not skipped",
+			args: args{now: time.Date(2024, 11, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC), repeat: "m 5"},
+			want: "20241205",
+		},
+		{
+			name: "m 10 12 - december after november",
+			args: args{now: time.Date(2024, 11, 20, 0, 0, 0, 0, time.UTC), date: time.Date(2023, 12, 10, 0, 0, 0, 0, time.UTC), repeat: "m 10 12"},
+			want: "20241210",
+		},
+		{
+			name: "m 1,15 11,12 - first of december",
+			args: args{now: time.Date(2024, 11, 20, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC), repeat: "m 1,15 11,12"},
+			want: "20241201",
+		},
+		{
+			name: "m 5 - january after december",
+			args: args{now: time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 12, 5, 0, 0, 0, 0, time.UTC), repeat: "m 5"},
+			want: "20250105",
+		},
+		{
+			name: "m 31 - short months are skipped",
+			args: args{now: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), repeat: "m 31"},
+			want: "20240331",
+		},
+		{
+			name: "m 2:2 - second tuesday",
+			args: args{now: time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC), repeat: "m 2:2"},
+			want: "20240213",
+		},
+		{
+			name: "m -1:5 3,6,9,12 - last friday of quarter",
+			args: args{now: time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2023, 12, 29, 0, 0, 0, 0, time.UTC), repeat: "m -1:5 3,6,9,12"},
+			want: "20240329",
+		},
+		{
+			name: "m 5:1 - fifth monday in the same month",
+			args: args{now: time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), repeat: "m 5:1"},
+			want: "20240129",
+		},
+		{
+			name: "m 5:3 - months without fifth wednesday are skipped",
+			args: args{now: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), repeat: "m 5:3"},
+			want: "20240529",
+		},
+		{
+			name: "m 1,1:1 - day and weekday mixed",
+			args: args{now: time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), repeat: "m 1,1:1"},
+			want: "20240201",
+		},
 		{
 			name: "m -1,18",
 			args: args{now: time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), repeat: "m -1,18"},
 			want: "20240131",
 		}, //
+		{
+			name: "d 7 until=20250215 - last occurrence",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 4, 0, 0, 0, 0, time.UTC), repeat: "d 7 until=20250215"},
+			want: "20250211",
+		},
+		{
+			name: "d 7 until=20250210 - rule has ended",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 4, 0, 0, 0, 0, time.UTC), repeat: "d 7 until=20250210"},
+			want: "",
+		},
 	}
 
 	for _, tc := range tests {
@@ -153,8 +279,480 @@ func TestNextDate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			//t.Parallel()
 
-			res := nextdate.NextDate(tc.args.now, tc.args.date, tc.args.repeat)
+			res, err := nextdate.NextDate(tc.args.now, tc.args.date, tc.args.repeat)
+			require.NoError(t, err)
 			assert.Equal(t, tc.want, res)
 		})
 	}
 }
+
+func TestNextDate_InvalidRule(t *testing.T) {
+	now := time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC)
+
+	for _, repeat := range []string{"", "d", "d 0", "x 1", "m -3"} {
+		_, err := nextdate.NextDate(now, now, repeat)
+
+		var parseErr *nextdate.ParseError
+		assert.ErrorAs(t, err, &parseErr, repeat)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		repeat  string
+		want    nextdate.Rule
+		wantErr string
+	}{
+		{
+			name:   "daily",
+			repeat: "d 400",
+			want:   nextdate.Daily{Interval: 400},
+		},
+		{
+			name:   "weekly is sorted",
+			repeat: "w 5,1,3",
+			want:   nextdate.Weekly{Weekdays: []int{1, 3, 5}},
+		},
+		{
+			name:   "monthly without months",
+			repeat: "m 16,-1,5",
+			want:   nextdate.Monthly{Days: []int{-1, 5, 16}},
+		},
+		{
+			name:   "monthly with months",
+			repeat: "m 1 12,6",
+			want:   nextdate.Monthly{Days: []int{1}, Months: []int{6, 12}},
+		},
+		{
+			name:   "monthly with ordinal weekdays",
+			repeat: "m -1:5,15,2:2 3,6",
+			want: nextdate.Monthly{
+				Days:     []int{15},
+				Weekdays: []nextdate.OrdinalWeekday{{Ordinal: -1, Weekday: 5}, {Ordinal: 2, Weekday: 2}},
+				Months:   []int{3, 6},
+			},
+		},
+		{
+			name:   "yearly",
+			repeat: "y",
+			want:   nextdate.Yearly{},
+		},
+		{
+			name:   "weekly with interval",
+			repeat: "w 4,1 2",
+			want:   nextdate.Weekly{Weekdays: []int{1, 4}, Interval: 2},
+		},
+		{
+			name:   "weekly with interval of one",
+			repeat: "w 1 1",
+			want:   nextdate.Weekly{Weekdays: []int{1}},
+		},
+		{
+			name:   "working days",
+			repeat: "b 3",
+			want:   nextdate.Workdays{Interval: 3},
+		},
+		{
+			name:   "monthly working days",
+			repeat: "bm 1,-1 3,6",
+			want:   nextdate.MonthlyWorkdays{Days: []int{-1, 1}, Months: []int{3, 6}},
+		},
+		{
+			name:   "shift modifier",
+			repeat: "m 15 shift=prev",
+			want:   nextdate.Shifted{Rule: nextdate.Monthly{Days: []int{15}}, Direction: nextdate.ShiftPrev},
+		},
+		{
+			name:   "end conditions wrap the shift",
+			repeat: "m 15 count=3 shift=next until=20251231",
+			want: nextdate.Limited{
+				Rule:  nextdate.Shifted{Rule: nextdate.Monthly{Days: []int{15}}, Direction: nextdate.ShiftNext},
+				Until: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+				Count: 3,
+			},
+		},
+		{
+			name:    "empty rule",
+			repeat:  "",
+			wantErr: `invalid repeat rule "" at position 1: rule is empty`,
+		},
+		{
+			name:    "unknown kind",
+			repeat:  "q 1",
+			wantErr: `invalid repeat rule "q 1" at position 1: unknown rule kind "q"`,
+		},
+		{
+			name:    "missing daily interval",
+			repeat:  "d",
+			wantErr: `invalid repeat rule "d" at position 2: rule "d" expects an argument`,
+		},
+		{
+			name:    "daily interval out of range",
+			repeat:  "d 401",
+			wantErr: `invalid repeat rule "d 401" at position 3: 401 is not a valid day interval`,
+		},
+		{
+			name:    "daily interval is not a number",
+			repeat:  "d seven",
+			wantErr: `invalid repeat rule "d seven" at position 3: "seven" is not a number`,
+		},
+		{
+			name:    "double space",
+			repeat:  "d  7",
+			wantErr: `invalid repeat rule "d  7" at position 3: unexpected space`,
+		},
+		{
+			name:    "weekday out of range",
+			repeat:  "w 1,8",
+			wantErr: `invalid repeat rule "w 1,8" at position 5: 8 is not a valid weekday`,
+		},
+		{
+			name:    "empty weekday",
+			repeat:  "w 1,,2",
+			wantErr: `invalid repeat rule "w 1,,2" at position 5: expected weekday`,
+		},
+		{
+			name:    "day of month out of range",
+			repeat:  "m 1,-3",
+			wantErr: `invalid repeat rule "m 1,-3" at position 5: -3 is not a valid day of month`,
+		},
+		{
+			name:    "weekday ordinal out of range",
+			repeat:  "m 6:1",
+			wantErr: `invalid repeat rule "m 6:1" at position 3: 6 is not a valid weekday ordinal`,
+		},
+		{
+			name:    "ordinal weekday out of range",
+			repeat:  "m 1,2:8",
+			wantErr: `invalid repeat rule "m 1,2:8" at position 7: 8 is not a valid weekday`,
+		},
+		{
+			name:    "days never occur",
+			repeat:  "m 30,31 2",
+			wantErr: `invalid repeat rule "m 30,31 2" at position 3: days "30,31" never occur in the listed months`,
+		},
+		{
+			name:    "month out of range",
+			repeat:  "m 1 13",
+			wantErr: `invalid repeat rule "m 1 13" at position 5: 13 is not a valid month`,
+		},
+		{
+			name:    "working day interval out of range",
+			repeat:  "b 0",
+			wantErr: `invalid repeat rule "b 0" at position 3: 0 is not a valid working day interval`,
+		},
+		{
+			name:    "working day of month out of range",
+			repeat:  "bm 24",
+			wantErr: `invalid repeat rule "bm 24" at position 4: 24 is not a valid working day of month`,
+		},
+		{
+			name:    "working days never occur",
+			repeat:  "bm 22 2",
+			wantErr: `invalid repeat rule "bm 22 2" at position 4: working days "22" never occur in the listed months`,
+		},
+		{
+			name:    "invalid shift direction",
+			repeat:  "m 15 shift=later",
+			wantErr: `invalid repeat rule "m 15 shift=later" at position 12: shift must be next or prev, got "later"`,
+		},
+		{
+			name:    "unknown modifier",
+			repeat:  "m 15 skip=1",
+			wantErr: `invalid repeat rule "m 15 skip=1" at position 6: unknown modifier "skip"`,
+		},
+		{
+			name:    "duplicate modifier",
+			repeat:  "y shift=next shift=prev",
+			wantErr: `invalid repeat rule "y shift=next shift=prev" at position 14: duplicate modifier "shift"`,
+		},
+		{
+			name:    "argument after modifier",
+			repeat:  "m 15 shift=next 3",
+			wantErr: `invalid repeat rule "m 15 shift=next 3" at position 17: expected key=value modifier, got "3"`,
+		},
+		{
+			name:    "week interval out of range",
+			repeat:  "w 1 53",
+			wantErr: `invalid repeat rule "w 1 53" at position 5: 53 is not a valid week interval`,
+		},
+		{
+			name:    "weekly with extra argument",
+			repeat:  "w 1 2 3",
+			wantErr: `invalid repeat rule "w 1 2 3" at position 7: unexpected argument "3"`,
+		},
+		{
+			name:    "invalid until date",
+			repeat:  "d 1 until=2025-12-31",
+			wantErr: `invalid repeat rule "d 1 until=2025-12-31" at position 11: until must be a date in YYYYMMDD format, got "2025-12-31"`,
+		},
+		{
+			name:    "zero count",
+			repeat:  "d 1 count=0",
+			wantErr: `invalid repeat rule "d 1 count=0" at position 11: 0 is not a valid occurrence count`,
+		},
+		{
+			name:    "yearly with argument",
+			repeat:  "y 1",
+			wantErr: `invalid repeat rule "y 1" at position 3: unexpected argument "1"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := nextdate.Parse(tc.repeat)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, rule)
+		})
+	}
+}
+
+func TestRule_String(t *testing.T) {
+	for _, repeat := range []string{"d 7", "w 1,3,5", "w 1,4 2", "m -2,-1,15", "m 1 1,7", "m 1,-1:5,2:2 12", "y", "b 3", "bm -1,1 3,6", "m 15 shift=next", "d 1 until=20251231", "w 1 shift=next count=5"} {
+		rule, err := nextdate.Parse(repeat)
+		require.NoError(t, err)
+		assert.Equal(t, repeat, rule.String())
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	tests := []struct {
+		name   string
+		start  time.Time
+		repeat string
+		n      int
+		want   []string
+	}{
+		{
+			name:   "daily",
+			start:  time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC),
+			repeat: "d 3",
+			n:      3,
+			want:   []string{"20250208", "20250211", "20250214"},
+		},
+		{
+			name:   "weekly",
+			start:  time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC),
+			repeat: "w 1,5",
+			n:      4,
+			want:   []string{"20250207", "20250210", "20250214", "20250217"},
+		},
+		{
+			name:   "every other week",
+			start:  time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC),
+			repeat: "w 1,4 2",
+			n:      4,
+			want:   []string{"20250206", "20250217", "20250220", "20250303"},
+		},
+		{
+			name:   "last friday of quarter",
+			start:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			repeat: "m -1:5 3,6,9,12",
+			n:      3,
+			want:   []string{"20240329", "20240628", "20240927"},
+		},
+		{
+			name:   "yearly",
+			start:  time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			repeat: "y",
+			n:      2,
+			want:   []string{"20250301", "20260301"},
+		},
+		{
+			name:   "until is inclusive",
+			start:  time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC),
+			repeat: "d 2 until=20250211",
+			n:      5,
+			want:   []string{"20250207", "20250209", "20250211"},
+		},
+		{
+			name:   "count includes the start date",
+			start:  time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC),
+			repeat: "d 1 count=3",
+			n:      5,
+			want:   []string{"20250206", "20250207"},
+		},
+		{
+			name:   "zero count",
+			start:  time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			repeat: "y",
+			n:      0,
+			want:   []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := nextdate.Parse(tc.repeat)
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(tc.want))
+			for _, date := range nextdate.Occurrences(tc.start, rule, tc.n) {
+				got = append(got, date.Format("20060102"))
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNextDate_Workdays(t *testing.T) {
+	holidays := nextdate.Holidays{}
+	for _, date := range []time.Time{
+		time.Date(2025, 2, 6, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 2, 7, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		holidays.Add(date)
+	}
+
+	type args struct {
+		now    time.Time
+		date   time.Time
+		repeat string
+		cal    nextdate.Calendar
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "b 3 - weekend is skipped",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), repeat: "b 3"},
+			want: "20250210",
+		},
+		{
+			name: "b 1 - holidays are skipped",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), repeat: "b 1", cal: holidays},
+			want: "20250210",
+		},
+		{
+			name: "b 2 - counted from the task date",
+			args: args{now: time.Date(2025, 2, 12, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "b 2"},
+			want: "20250213",
+		},
+		{
+			name: "bm 1 - first working day after weekend",
+			args: args{now: time.Date(2025, 2, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "bm 1"},
+			want: "20250303",
+		},
+		{
+			name: "bm 1 - first working day after holiday",
+			args: args{now: time.Date(2025, 2, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "bm 1", cal: holidays},
+			want: "20250304",
+		},
+		{
+			name: "bm -1 - last working day",
+			args: args{now: time.Date(2025, 2, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), repeat: "bm -1"},
+			want: "20250228",
+		},
+		{
+			name: "bm 1 1 - limited to january",
+			args: args{now: time.Date(2025, 2, 26, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), repeat: "bm 1 1", cal: holidays},
+			want: "20260102",
+		},
+		{
+			name: "m 15 shift=next - saturday moves to monday",
+			args: args{now: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), repeat: "m 15 shift=next"},
+			want: "20250217",
+		},
+		{
+			name: "m 15 shift=prev - saturday moves to friday",
+			args: args{now: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), repeat: "m 15 shift=prev"},
+			want: "20250214",
+		},
+		{
+			name: "m 15 shift=prev - shifted day already passed",
+			args: args{now: time.Date(2025, 2, 14, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), repeat: "m 15 shift=prev"},
+			want: "20250314",
+		},
+		{
+			name: "d 1 shift=next - holiday before weekend",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), repeat: "d 1 shift=next", cal: holidays},
+			want: "20250210",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := nextdate.NextDate(tc.args.now, tc.args.date, tc.args.repeat, nextdate.WithCalendar(tc.args.cal))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestNextDate_Excluded(t *testing.T) {
+	excluded := []time.Time{
+		time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 2, 17, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	type args struct {
+		now    time.Time
+		date   time.Time
+		repeat string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "w 1 - excluded dates in a row are jumped over",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "w 1"},
+			want: "20250224",
+		},
+		{
+			name: "d 7 - excluded date in the past is ignored",
+			args: args{now: time.Date(2025, 2, 18, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "d 7"},
+			want: "20250224",
+		},
+		{
+			name: "m 1 - next month is excluded",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), repeat: "m 1"},
+			want: "20250401",
+		},
+		{
+			name: "w 1 until=20250220 - rule ends while jumping",
+			args: args{now: time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), repeat: "w 1 until=20250220"},
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := nextdate.NextDate(tc.args.now, tc.args.date, tc.args.repeat, nextdate.WithExcluded(excluded...))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+
+	t.Run("occurrences leave excluded dates out", func(t *testing.T) {
+		t.Parallel()
+
+		rule, err := nextdate.Parse("w 1")
+		require.NoError(t, err)
+
+		got := nextdate.Occurrences(time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), rule, 2, nextdate.WithExcluded(excluded...))
+		assert.Equal(t, []time.Time{time.Date(2025, 2, 24, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)}, got)
+	})
+}
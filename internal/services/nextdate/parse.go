@@ -0,0 +1,324 @@
+package nextdate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib"
+)
+
+const (
+	maxDailyInterval   = 400   // Longest interval allowed in daily and working day rules
+	maxWeeklyInterval  = 52    // Longest interval allowed in weekly rules
+	maxOccurrenceCount = 10000 // Largest count allowed by the count modifier
+)
+
+// ParseError describes a repeat rule which can not be parsed.
+type ParseError struct {
+	Rule string // Rule is the original rule text.
+	Pos  int    // Pos is the 1-based column of the offending token.
+	Msg  string // Msg explains what is wrong with the token.
+}
+
+// Error implements error.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid repeat rule %q at position %d: %s", e.Rule, e.Pos, e.Msg)
+}
+
+// token is a piece of a repeat rule together with its 1-based column.
+type token struct {
+	text string
+	pos  int
+}
+
+// split breaks the token into parts divided by sep, keeping track of their columns.
+func (t token) split(sep string) []token {
+	parts := strings.Split(t.text, sep)
+	tokens := make([]token, len(parts))
+	pos := t.pos
+	for i, part := range parts {
+		tokens[i] = token{text: part, pos: pos}
+		pos += len(part) + len(sep)
+	}
+	return tokens
+}
+
+type parser struct {
+	rule string
+}
+
+func (p parser) errorf(pos int, format string, args ...any) error {
+	return &ParseError{Rule: p.rule, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// number parses an integer token and checks that it is one of the allowed values.
+func (p parser) number(t token, what string, allowed func(int) bool) (int, error) {
+	if t.text == "" {
+		return 0, p.errorf(t.pos, "expected %s", what)
+	}
+	n, err := strconv.Atoi(t.text)
+	if err != nil {
+		return 0, p.errorf(t.pos, "%q is not a number", t.text)
+	}
+	if !allowed(n) {
+		return 0, p.errorf(t.pos, "%d is not a valid %s", n, what)
+	}
+	return n, nil
+}
+
+// list parses a comma separated list of integers and returns it sorted.
+func (p parser) list(t token, what string, allowed func(int) bool) ([]int, error) {
+	parts := t.split(",")
+	values := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, err := p.number(part, what, allowed)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, n)
+	}
+	sort.Ints(values)
+	return values, nil
+}
+
+func between(lo, hi int) func(int) bool {
+	return func(n int) bool { return n >= lo && n <= hi }
+}
+
+func isMonthDay(n int) bool {
+	return between(1, 31)(n) || n == -1 || n == -2
+}
+
+// maxMonthWorkdays is the largest number of working days in a month.
+const maxMonthWorkdays = 23
+
+func isMonthWorkday(n int) bool {
+	return between(1, maxMonthWorkdays)(n) || between(-maxMonthWorkdays, -1)(n)
+}
+
+func isOrdinal(n int) bool {
+	return between(1, 5)(n) || n == -1
+}
+
+// monthDays parses the days list of a monthly rule.
+// Every item is either a day of month or an ordinal weekday such as "2:2".
+func (p parser) monthDays(t token) (Monthly, error) {
+	var rule Monthly
+	for _, part := range t.split(",") {
+		ordinal, weekday, isWeekday := strings.Cut(part.text, ":")
+		if !isWeekday {
+			day, err := p.number(part, "day of month", isMonthDay)
+			if err != nil {
+				return Monthly{}, err
+			}
+			rule.Days = append(rule.Days, day)
+			continue
+		}
+
+		n, err := p.number(token{text: ordinal, pos: part.pos}, "weekday ordinal", isOrdinal)
+		if err != nil {
+			return Monthly{}, err
+		}
+		wd, err := p.number(token{text: weekday, pos: part.pos + len(ordinal) + 1}, "weekday", between(1, 7))
+		if err != nil {
+			return Monthly{}, err
+		}
+		rule.Weekdays = append(rule.Weekdays, OrdinalWeekday{Ordinal: n, Weekday: wd})
+	}
+
+	sort.Ints(rule.Days)
+	sort.Slice(rule.Weekdays, func(i, j int) bool {
+		a, b := rule.Weekdays[i], rule.Weekdays[j]
+		if a.Ordinal != b.Ordinal {
+			return a.Ordinal < b.Ordinal
+		}
+		return a.Weekday < b.Weekday
+	})
+	return rule, nil
+}
+
+// Parse converts a textual repeat rule into a typed Rule.
+// The returned error is a *ParseError pointing at the offending part of the rule.
+func Parse(repeat string) (Rule, error) {
+	p := parser{rule: repeat}
+	if repeat == "" {
+		return nil, p.errorf(1, "rule is empty")
+	}
+
+	tokens := token{text: repeat, pos: 1}.split(" ")
+	for _, t := range tokens {
+		if t.text == "" {
+			return nil, p.errorf(t.pos, "unexpected space")
+		}
+	}
+
+	args := tokens[1:]
+	var modifiers []token
+	for i, t := range args {
+		if strings.Contains(t.text, "=") {
+			args, modifiers = args[:i], args[i:]
+			break
+		}
+	}
+
+	rule, err := p.base(tokens[0], args)
+	if err != nil {
+		return nil, err
+	}
+	return p.modify(rule, modifiers)
+}
+
+// base parses the rule kind and its positional arguments.
+func (p parser) base(kind token, args []token) (Rule, error) {
+	switch kind.text {
+	case "d":
+		if err := p.arity(kind, args, 1, 1); err != nil {
+			return nil, err
+		}
+		days, err := p.number(args[0], "day interval", between(1, maxDailyInterval))
+		if err != nil {
+			return nil, err
+		}
+		return Daily{Interval: days}, nil
+	case "w":
+		if err := p.arity(kind, args, 1, 2); err != nil {
+			return nil, err
+		}
+		weekdays, err := p.list(args[0], "weekday", between(1, 7))
+		if err != nil {
+			return nil, err
+		}
+		rule := Weekly{Weekdays: weekdays}
+		if len(args) > 1 {
+			interval, err := p.number(args[1], "week interval", between(1, maxWeeklyInterval))
+			if err != nil {
+				return nil, err
+			}
+			if interval > 1 {
+				rule.Interval = interval
+			}
+		}
+		return rule, nil
+	case "m":
+		if err := p.arity(kind, args, 1, 2); err != nil {
+			return nil, err
+		}
+		rule, err := p.monthDays(args[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(args) > 1 {
+			rule.Months, err = p.list(args[1], "month", between(1, 12))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if rule.Next(time.Time{}, time.Time{}, nil).IsZero() {
+			return nil, p.errorf(args[0].pos, "days %q never occur in the listed months", args[0].text)
+		}
+		return rule, nil
+	case "y":
+		if err := p.arity(kind, args, 0, 0); err != nil {
+			return nil, err
+		}
+		return Yearly{}, nil
+	case "b":
+		if err := p.arity(kind, args, 1, 1); err != nil {
+			return nil, err
+		}
+		days, err := p.number(args[0], "working day interval", between(1, maxDailyInterval))
+		if err != nil {
+			return nil, err
+		}
+		return Workdays{Interval: days}, nil
+	case "bm":
+		if err := p.arity(kind, args, 1, 2); err != nil {
+			return nil, err
+		}
+		days, err := p.list(args[0], "working day of month", isMonthWorkday)
+		if err != nil {
+			return nil, err
+		}
+		rule := MonthlyWorkdays{Days: days}
+		if len(args) > 1 {
+			rule.Months, err = p.list(args[1], "month", between(1, 12))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if rule.Next(time.Time{}, time.Time{}, nil).IsZero() {
+			return nil, p.errorf(args[0].pos, "working days %q never occur in the listed months", args[0].text)
+		}
+		return rule, nil
+	default:
+		return nil, p.errorf(kind.pos, "unknown rule kind %q", kind.text)
+	}
+}
+
+// modify applies "key=value" modifiers which follow the positional arguments of a rule.
+// Whatever their order, a shift is applied first and end conditions wrap the result.
+func (p parser) modify(rule Rule, modifiers []token) (Rule, error) {
+	var (
+		seen    = make(map[string]bool)
+		limited Limited
+	)
+	for _, modifier := range modifiers {
+		key, value, ok := strings.Cut(modifier.text, "=")
+		if !ok {
+			return nil, p.errorf(modifier.pos, "expected key=value modifier, got %q", modifier.text)
+		}
+		if seen[key] {
+			return nil, p.errorf(modifier.pos, "duplicate modifier %q", key)
+		}
+		seen[key] = true
+
+		valuePos := modifier.pos + len(key) + 1
+		switch key {
+		case "shift":
+			var direction Shift
+			switch value {
+			case "next":
+				direction = ShiftNext
+			case "prev":
+				direction = ShiftPrev
+			default:
+				return nil, p.errorf(valuePos, "shift must be next or prev, got %q", value)
+			}
+			rule = Shifted{Rule: rule, Direction: direction}
+		case "until":
+			until, err := time.Parse(lib.DateFormat, value)
+			if err != nil {
+				return nil, p.errorf(valuePos, "until must be a date in YYYYMMDD format, got %q", value)
+			}
+			limited.Until = until
+		case "count":
+			count, err := p.number(token{text: value, pos: valuePos}, "occurrence count", between(1, maxOccurrenceCount))
+			if err != nil {
+				return nil, err
+			}
+			limited.Count = count
+		default:
+			return nil, p.errorf(modifier.pos, "unknown modifier %q", key)
+		}
+	}
+
+	if seen["until"] || seen["count"] {
+		limited.Rule = rule
+		return limited, nil
+	}
+	return rule, nil
+}
+
+// arity checks that the rule kind got between lo and hi arguments.
+func (p parser) arity(kind token, args []token, lo, hi int) error {
+	if len(args) < lo {
+		return p.errorf(kind.pos+len(kind.text), "rule %q expects an argument", kind.text)
+	}
+	if len(args) > hi {
+		return p.errorf(args[hi].pos, "unexpected argument %q", args[hi].text)
+	}
+	return nil
+}
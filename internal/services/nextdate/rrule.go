@@ -0,0 +1,336 @@
+package nextdate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/10Narratives/task-tracker/internal/lib"
+)
+
+var (
+	// ErrInvalidRRULE is returned for RRULE strings which do not follow RFC 5545.
+	ErrInvalidRRULE = errors.New("invalid RRULE")
+	// ErrUnsupportedRRULE is returned for valid RRULE strings which have no repeat rule equivalent.
+	ErrUnsupportedRRULE = errors.New("unsupported RRULE")
+)
+
+const rrulePrefix = "RRULE:"
+
+var rruleWeekdays = [...]string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+// IsRRULE reports whether the repeat value looks like an RFC 5545 recurrence rule
+// rather than a native repeat rule: either it starts with "RRULE:", or it is a list of NAME=VALUE parts
+// separated by semicolons, in any order, one of which is FREQ.
+func IsRRULE(repeat string) bool {
+	upper := strings.ToUpper(strings.TrimSpace(repeat))
+	if strings.HasPrefix(upper, rrulePrefix) {
+		return true
+	}
+
+	var freq bool
+	for _, part := range strings.Split(upper, ";") {
+		name, _, ok := strings.Cut(part, "=")
+		if !ok || name == "" || strings.Trim(name, "ABCDEFGHIJKLMNOPQRSTUVWXYZ-") != "" {
+			return false
+		}
+		freq = freq || name == "FREQ"
+	}
+	return freq
+}
+
+// ToRRULE converts a repeat rule into an RFC 5545 recurrence rule, e.g. "w 1,3" becomes "FREQ=WEEKLY;BYDAY=MO,WE".
+// It returns ErrUnsupportedRRULE if the rule has no RRULE equivalent.
+func ToRRULE(rule Rule) (string, error) {
+	switch r := rule.(type) {
+	case Daily:
+		if r.Interval == 1 {
+			return "FREQ=DAILY", nil
+		}
+		return "FREQ=DAILY;INTERVAL=" + strconv.Itoa(r.Interval), nil
+	case Weekly:
+		if r.Interval > 1 {
+			return "FREQ=WEEKLY;INTERVAL=" + strconv.Itoa(r.Interval) + ";BYDAY=" + formatRRULEWeekdays(r.Weekdays), nil
+		}
+		return "FREQ=WEEKLY;BYDAY=" + formatRRULEWeekdays(r.Weekdays), nil
+	case Monthly:
+		var parts []string
+		switch {
+		case len(r.Days) > 0 && len(r.Weekdays) > 0:
+			return "", fmt.Errorf("%w: rule %q mixes days of month and weekdays", ErrUnsupportedRRULE, r)
+		case len(r.Days) > 0:
+			parts = append(parts, "FREQ=MONTHLY", "BYMONTHDAY="+joinInts(r.Days))
+		default:
+			parts = append(parts, "FREQ=MONTHLY", "BYDAY="+formatRRULEOrdinals(r.Weekdays))
+		}
+		if len(r.Months) > 0 {
+			parts = append(parts, "BYMONTH="+joinInts(r.Months))
+		}
+		return strings.Join(parts, ";"), nil
+	case Yearly:
+		return "FREQ=YEARLY", nil
+	case Limited:
+		if !r.Until.IsZero() && r.Count > 0 {
+			return "", fmt.Errorf("%w: rule %q combines until and count", ErrUnsupportedRRULE, r)
+		}
+		base, err := ToRRULE(r.Rule)
+		if err != nil {
+			return "", err
+		}
+		if r.Count > 0 {
+			return base + ";COUNT=" + strconv.Itoa(r.Count), nil
+		}
+		return base + ";UNTIL=" + r.Until.Format(lib.DateFormat), nil
+	default:
+		return "", fmt.Errorf("%w: rule %q", ErrUnsupportedRRULE, rule)
+	}
+}
+
+// FromRRULE converts the supported subset of RFC 5545 recurrence rules into a repeat rule.
+// Supported are DAILY rules with INTERVAL, WEEKLY rules with BYDAY and INTERVAL, MONTHLY rules with either
+// BYMONTHDAY or ordinal BYDAY and optional BYMONTH, and plain YEARLY rules.
+// Any of them may end with UNTIL or COUNT; the time part of UNTIL is ignored.
+func FromRRULE(rrule string) (Rule, error) {
+	parts, err := splitRRULE(rrule)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := 1
+	if value, ok := parts["INTERVAL"]; ok {
+		interval, err = strconv.Atoi(value)
+		if err != nil || interval < 1 {
+			return nil, fmt.Errorf("%w: INTERVAL %q is not a positive number", ErrInvalidRRULE, value)
+		}
+		delete(parts, "INTERVAL")
+	}
+	delete(parts, "WKST")
+
+	var limits string
+	if value, ok := parts["UNTIL"]; ok {
+		if len(value) < len(lib.DateFormat) {
+			return nil, fmt.Errorf("%w: UNTIL %q is not a date", ErrInvalidRRULE, value)
+		}
+		limits += " until=" + value[:len(lib.DateFormat)]
+		delete(parts, "UNTIL")
+	}
+	if value, ok := parts["COUNT"]; ok {
+		count, err := strconv.Atoi(value)
+		if err != nil || count < 1 {
+			return nil, fmt.Errorf("%w: COUNT %q is not a positive number", ErrInvalidRRULE, value)
+		}
+		if count > maxOccurrenceCount {
+			return nil, fmt.Errorf("%w: COUNT above %d", ErrUnsupportedRRULE, maxOccurrenceCount)
+		}
+		limits += " count=" + value
+		delete(parts, "COUNT")
+	}
+
+	freq := parts["FREQ"]
+	delete(parts, "FREQ")
+
+	var repeat string
+	switch freq {
+	case "DAILY":
+		if interval > maxDailyInterval {
+			return nil, fmt.Errorf("%w: DAILY rules with INTERVAL above %d", ErrUnsupportedRRULE, maxDailyInterval)
+		}
+		repeat = "d " + strconv.Itoa(interval)
+	case "WEEKLY":
+		byDay, ok := parts["BYDAY"]
+		if !ok {
+			return nil, fmt.Errorf("%w: WEEKLY rules require BYDAY", ErrUnsupportedRRULE)
+		}
+		if interval > maxWeeklyInterval {
+			return nil, fmt.Errorf("%w: WEEKLY rules with INTERVAL above %d", ErrUnsupportedRRULE, maxWeeklyInterval)
+		}
+		delete(parts, "BYDAY")
+		days, err := parseRRULEWeekdays(byDay)
+		if err != nil {
+			return nil, err
+		}
+		repeat = "w " + days + " " + strconv.Itoa(interval)
+	case "MONTHLY", "YEARLY":
+		if interval != 1 {
+			return nil, fmt.Errorf("%w: %s rules with INTERVAL are not supported", ErrUnsupportedRRULE, freq)
+		}
+		if freq == "YEARLY" && len(parts) == 0 {
+			repeat = "y"
+			break
+		}
+		if freq == "YEARLY" && parts["BYMONTH"] == "" {
+			return nil, fmt.Errorf("%w: YEARLY rules with BYDAY or BYMONTHDAY require BYMONTH", ErrUnsupportedRRULE)
+		}
+		repeat, err = monthlyFromRRULE(freq, parts)
+		if err != nil {
+			return nil, err
+		}
+	case "":
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRRULE)
+	default:
+		return nil, fmt.Errorf("%w: FREQ=%s", ErrUnsupportedRRULE, freq)
+	}
+
+	if len(parts) > 0 {
+		keys := make([]string, 0, len(parts))
+		for key := range parts {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return nil, fmt.Errorf("%w: %s is not supported for FREQ=%s", ErrUnsupportedRRULE, strings.Join(keys, ", "), freq)
+	}
+
+	// The values were checked against RFC 5545 and the limits of the repeat rules above,
+	// so the rule is only rejected here if it can never occur, e.g. BYMONTHDAY=31;BYMONTH=2.
+	rule, err := Parse(repeat + limits)
+	if err != nil {
+		var parseErr *ParseError
+		if errors.As(err, &parseErr) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRRULE, parseErr.Msg)
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+// monthlyFromRRULE builds a monthly rule from BYMONTHDAY or BYDAY and optional BYMONTH parts.
+// Consumed parts are removed from the map.
+func monthlyFromRRULE(freq string, parts map[string]string) (string, error) {
+	byMonthDay, hasMonthDay := parts["BYMONTHDAY"]
+	byDay, hasDay := parts["BYDAY"]
+	delete(parts, "BYMONTHDAY")
+	delete(parts, "BYDAY")
+
+	var days string
+	switch {
+	case hasMonthDay && hasDay:
+		return "", fmt.Errorf("%w: BYMONTHDAY combined with BYDAY", ErrUnsupportedRRULE)
+	case hasMonthDay:
+		if err := checkRRULEMonthDays(byMonthDay); err != nil {
+			return "", err
+		}
+		days = byMonthDay
+	case hasDay:
+		ordinals, err := parseRRULEOrdinals(byDay)
+		if err != nil {
+			return "", err
+		}
+		days = ordinals
+	default:
+		return "", fmt.Errorf("%w: %s rules require BYMONTHDAY or BYDAY", ErrUnsupportedRRULE, freq)
+	}
+
+	repeat := "m " + days
+	if byMonth, ok := parts["BYMONTH"]; ok {
+		delete(parts, "BYMONTH")
+		for _, value := range strings.Split(byMonth, ",") {
+			if month, err := strconv.Atoi(value); err != nil || !between(1, 12)(month) {
+				return "", fmt.Errorf("%w: BYMONTH %q is not a month", ErrInvalidRRULE, value)
+			}
+		}
+		repeat += " " + byMonth
+	}
+	return repeat, nil
+}
+
+// checkRRULEMonthDays checks a BYMONTHDAY list. RFC 5545 allows the days 1 to 31 counted from either end
+// of the month, while repeat rules count only the last two days from the end.
+func checkRRULEMonthDays(byMonthDay string) error {
+	for _, value := range strings.Split(byMonthDay, ",") {
+		day, err := strconv.Atoi(value)
+		if err != nil || !between(1, 31)(day) && !between(-31, -1)(day) {
+			return fmt.Errorf("%w: BYMONTHDAY %q is not a day of month", ErrInvalidRRULE, value)
+		}
+		if !isMonthDay(day) {
+			return fmt.Errorf("%w: BYMONTHDAY %d, only -1 and -2 are supported from the end of the month", ErrUnsupportedRRULE, day)
+		}
+	}
+	return nil
+}
+
+// splitRRULE splits an RRULE into upper-cased NAME=VALUE parts.
+func splitRRULE(rrule string) (map[string]string, error) {
+	rrule = strings.ToUpper(strings.TrimSpace(rrule))
+	rrule = strings.TrimPrefix(rrule, rrulePrefix)
+
+	parts := make(map[string]string)
+	for _, part := range strings.Split(rrule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRRULE, part)
+		}
+		if _, dup := parts[name]; dup {
+			return nil, fmt.Errorf("%w: duplicate part %s", ErrInvalidRRULE, name)
+		}
+		parts[name] = value
+	}
+	return parts, nil
+}
+
+func rruleWeekday(code string) (int, error) {
+	for i, weekday := range rruleWeekdays {
+		if weekday == code {
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unknown weekday %q", ErrInvalidRRULE, code)
+}
+
+// parseRRULEWeekdays converts a BYDAY list without ordinals into native weekday numbers.
+func parseRRULEWeekdays(byDay string) (string, error) {
+	codes := strings.Split(byDay, ",")
+	days := make([]string, len(codes))
+	for i, code := range codes {
+		if len(code) != 2 {
+			return "", fmt.Errorf("%w: BYDAY %q with ordinal in a WEEKLY rule", ErrUnsupportedRRULE, code)
+		}
+		day, err := rruleWeekday(code)
+		if err != nil {
+			return "", err
+		}
+		days[i] = strconv.Itoa(day)
+	}
+	return strings.Join(days, ","), nil
+}
+
+// parseRRULEOrdinals converts a BYDAY list with ordinals, e.g. "2TU,-1FR", into native ordinal weekdays.
+func parseRRULEOrdinals(byDay string) (string, error) {
+	codes := strings.Split(byDay, ",")
+	days := make([]string, len(codes))
+	for i, code := range codes {
+		if len(code) <= 2 {
+			return "", fmt.Errorf("%w: BYDAY %q without ordinal in a MONTHLY rule", ErrUnsupportedRRULE, code)
+		}
+		ordinal, err := strconv.Atoi(strings.TrimPrefix(code[:len(code)-2], "+"))
+		if err != nil || !between(1, 53)(ordinal) && !between(-53, -1)(ordinal) {
+			return "", fmt.Errorf("%w: BYDAY %q has invalid ordinal", ErrInvalidRRULE, code)
+		}
+		if !isOrdinal(ordinal) {
+			return "", fmt.Errorf("%w: BYDAY %q, only the ordinals 1 to 5 and -1 are supported", ErrUnsupportedRRULE, code)
+		}
+		weekday, err := rruleWeekday(code[len(code)-2:])
+		if err != nil {
+			return "", err
+		}
+		days[i] = OrdinalWeekday{Ordinal: ordinal, Weekday: weekday}.String()
+	}
+	return strings.Join(days, ","), nil
+}
+
+func formatRRULEWeekdays(weekdays []int) string {
+	codes := make([]string, len(weekdays))
+	for i, weekday := range weekdays {
+		codes[i] = rruleWeekdays[weekday-1]
+	}
+	return strings.Join(codes, ",")
+}
+
+func formatRRULEOrdinals(weekdays []OrdinalWeekday) string {
+	codes := make([]string, len(weekdays))
+	for i, weekday := range weekdays {
+		codes[i] = strconv.Itoa(weekday.Ordinal) + rruleWeekdays[weekday.Weekday-1]
+	}
+	return strings.Join(codes, ",")
+}
@@ -0,0 +1,146 @@
+package nextdate_test
+
+import (
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/services/nextdate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToRRULE(t *testing.T) {
+	tests := []struct {
+		repeat  string
+		want    string
+		wantErr error
+	}{
+		{repeat: "d 1", want: "FREQ=DAILY"},
+		{repeat: "d 14", want: "FREQ=DAILY;INTERVAL=14"},
+		{repeat: "w 1,3,7", want: "FREQ=WEEKLY;BYDAY=MO,WE,SU"},
+		{repeat: "w 1,4 2", want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"},
+		{repeat: "m 1,-1", want: "FREQ=MONTHLY;BYMONTHDAY=-1,1"},
+		{repeat: "m 15 3,9", want: "FREQ=MONTHLY;BYMONTHDAY=15;BYMONTH=3,9"},
+		{repeat: "m 2:2,-1:5", want: "FREQ=MONTHLY;BYDAY=-1FR,2TU"},
+		{repeat: "y", want: "FREQ=YEARLY"},
+		{repeat: "d 1 until=20251231", want: "FREQ=DAILY;UNTIL=20251231"},
+		{repeat: "w 5 count=10", want: "FREQ=WEEKLY;BYDAY=FR;COUNT=10"},
+		{repeat: "d 1 until=20251231 count=10", wantErr: nextdate.ErrUnsupportedRRULE},
+		{repeat: "m 1,2:2", wantErr: nextdate.ErrUnsupportedRRULE},
+		{repeat: "b 3", wantErr: nextdate.ErrUnsupportedRRULE},
+		{repeat: "m 15 shift=next", wantErr: nextdate.ErrUnsupportedRRULE},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.repeat, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := nextdate.Parse(tc.repeat)
+			require.NoError(t, err)
+
+			got, err := nextdate.ToRRULE(rule)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestIsRRULE(t *testing.T) {
+	tests := []struct {
+		repeat string
+		want   bool
+	}{
+		{repeat: "RRULE:FREQ=DAILY", want: true},
+		{repeat: "FREQ=WEEKLY;BYDAY=MO,WE", want: true},
+		{repeat: "BYDAY=MO,WE;FREQ=WEEKLY", want: true},
+		{repeat: "interval=2;freq=daily", want: true},
+		{repeat: "INTERVAL=2", want: false},
+		{repeat: "d 1 count=2", want: false},
+		{repeat: "w 1,3", want: false},
+		{repeat: "y", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.repeat, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, nextdate.IsRRULE(tc.repeat))
+		})
+	}
+}
+
+func TestFromRRULE(t *testing.T) {
+	tests := []struct {
+		rrule   string
+		want    string
+		wantErr string
+	}{
+		{rrule: "FREQ=DAILY", want: "d 1"},
+		{rrule: "RRULE:FREQ=DAILY;INTERVAL=3", want: "d 3"},
+		{rrule: "freq=weekly;byday=mo,we", want: "w 1,3"},
+		{rrule: "BYDAY=MO,WE;FREQ=WEEKLY", want: "w 1,3"},
+		{rrule: "FREQ=WEEKLY;BYDAY=FR,MO;WKST=MO", want: "w 1,5"},
+		{rrule: "FREQ=MONTHLY;BYMONTHDAY=1,-1", want: "m -1,1"},
+		{rrule: "FREQ=MONTHLY;BYDAY=2TU", want: "m 2:2"},
+		{rrule: "FREQ=MONTHLY;BYDAY=-1FR;BYMONTH=3,6,9,12", want: "m -1:5 3,6,9,12"},
+		{rrule: "FREQ=YEARLY", want: "y"},
+		{rrule: "FREQ=YEARLY;BYMONTH=7;BYMONTHDAY=4", want: "m 4 7"},
+		{rrule: "FREQ=DAILY;UNTIL=20251231T235959Z", want: "d 1 until=20251231"},
+		{rrule: "FREQ=MONTHLY;BYMONTHDAY=1;COUNT=12", want: "m 1 count=12"},
+		{rrule: "FREQ=HOURLY", wantErr: "unsupported RRULE: FREQ=HOURLY"},
+		{rrule: "FREQ=WEEKLY", wantErr: "unsupported RRULE: WEEKLY rules require BYDAY"},
+		{rrule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH", want: "w 1,4 2"},
+		{rrule: "FREQ=WEEKLY;INTERVAL=60;BYDAY=MO", wantErr: "unsupported RRULE: WEEKLY rules with INTERVAL above 52"},
+		{rrule: "FREQ=WEEKLY;BYDAY=1MO", wantErr: `unsupported RRULE: BYDAY "1MO" with ordinal in a WEEKLY rule`},
+		{rrule: "FREQ=MONTHLY;BYDAY=TU", wantErr: `unsupported RRULE: BYDAY "TU" without ordinal in a MONTHLY rule`},
+		{rrule: "FREQ=MONTHLY;BYMONTHDAY=1;BYDAY=1MO", wantErr: "unsupported RRULE: BYMONTHDAY combined with BYDAY"},
+		{rrule: "FREQ=DAILY;COUNT=5;BYHOUR=9", wantErr: "unsupported RRULE: BYHOUR is not supported for FREQ=DAILY"},
+		{rrule: "FREQ=DAILY;INTERVAL=500", wantErr: "unsupported RRULE: DAILY rules with INTERVAL above 400"},
+		{rrule: "FREQ=MONTHLY;BYMONTHDAY=31;BYMONTH=2", wantErr: `invalid RRULE: days "31" never occur in the listed months`},
+		{rrule: "FREQ=WEEKLY;BYDAY=XX", wantErr: `invalid RRULE: unknown weekday "XX"`},
+		{rrule: "FREQ=DAILY;COUNT=0", wantErr: `invalid RRULE: COUNT "0" is not a positive number`},
+		{rrule: "FREQ=DAILY;COUNT=20000", wantErr: "unsupported RRULE: COUNT above 10000"},
+		{rrule: "FREQ=MONTHLY;BYMONTHDAY=-3", wantErr: "unsupported RRULE: BYMONTHDAY -3, only -1 and -2 are supported from the end of the month"},
+		{rrule: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: `invalid RRULE: BYMONTHDAY "32" is not a day of month`},
+		{rrule: "FREQ=MONTHLY;BYMONTHDAY=0", wantErr: `invalid RRULE: BYMONTHDAY "0" is not a day of month`},
+		{rrule: "FREQ=MONTHLY;BYMONTHDAY=1;BYMONTH=13", wantErr: `invalid RRULE: BYMONTH "13" is not a month`},
+		{rrule: "FREQ=MONTHLY;BYDAY=-2FR", wantErr: `unsupported RRULE: BYDAY "-2FR", only the ordinals 1 to 5 and -1 are supported`},
+		{rrule: "FREQ=MONTHLY;BYDAY=0MO", wantErr: `invalid RRULE: BYDAY "0MO" has invalid ordinal`},
+		{rrule: "FREQ=DAILY;UNTIL=2025", wantErr: `invalid RRULE: UNTIL "2025" is not a date`},
+		{rrule: "INTERVAL=2", wantErr: "invalid RRULE: FREQ is required"},
+		{rrule: "FREQ=DAILY;;", wantErr: `invalid RRULE: malformed part ""`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.rrule, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := nextdate.FromRRULE(tc.rrule)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, rule.String())
+		})
+	}
+}
+
+func TestRRULE_RoundTrip(t *testing.T) {
+	for _, repeat := range []string{"d 1", "d 7", "w 2,4,6", "w 2,5 3", "m -2,10", "m 3:1 1,4,7,10", "y", "d 2 count=4"} {
+		rule, err := nextdate.Parse(repeat)
+		require.NoError(t, err)
+
+		rrule, err := nextdate.ToRRULE(rule)
+		require.NoError(t, err)
+
+		back, err := nextdate.FromRRULE(rrule)
+		require.NoError(t, err)
+		assert.Equal(t, rule, back, repeat)
+	}
+}
@@ -0,0 +1,260 @@
+package nextdate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib"
+)
+
+// Rule is a parsed repeat rule.
+// Every rule knows how to find its next occurrence and how to print itself
+// back in the textual form accepted by Parse.
+type Rule interface {
+	// Next returns the first occurrence of the rule after now for a task scheduled on date.
+	// The calendar tells which days are holidays, it may be nil.
+	Next(now, date time.Time, cal Calendar) time.Time
+
+	// String returns the canonical textual form of the rule.
+	String() string
+}
+
+// Daily repeats a task every Interval days ("d <n>").
+type Daily struct {
+	Interval int
+}
+
+// Next implements Rule.
+func (r Daily) Next(now, date time.Time, cal Calendar) time.Time {
+	return shiftDaily(now, date, r.Interval)
+}
+
+// String implements Rule.
+func (r Daily) String() string {
+	return "d " + strconv.Itoa(r.Interval)
+}
+
+// Weekly repeats a task on the listed weekdays ("w <1-7>[,...] [interval]"), 1 is Monday and 7 is Sunday.
+// With an Interval above 1 only every Interval-th week counted from the week of the task date is used,
+// e.g. "w 1,4 2" is every other Monday and Thursday.
+type Weekly struct {
+	Weekdays []int
+	Interval int
+}
+
+// Next implements Rule.
+func (r Weekly) Next(now, date time.Time, cal Calendar) time.Time {
+	return shiftIntervalWeekly(now, date, r.Weekdays, r.Interval)
+}
+
+// String implements Rule.
+func (r Weekly) String() string {
+	s := "w " + joinInts(r.Weekdays)
+	if r.Interval > 1 {
+		s += " " + strconv.Itoa(r.Interval)
+	}
+	return s
+}
+
+// OrdinalWeekday is the N-th weekday of a month written as "<ordinal>:<weekday>".
+// Ordinal runs from 1 to 5, -1 stands for the last such weekday of a month.
+type OrdinalWeekday struct {
+	Ordinal int
+	Weekday int
+}
+
+// dayIn returns the day of month on which the weekday falls in the given month.
+// The second result is false if the month has no such weekday.
+func (w OrdinalWeekday) dayIn(month time.Month, year int) (int, bool) {
+	totalDays := daysInMonth(month, year)
+	if w.Ordinal < 0 {
+		last := time.Date(year, month, totalDays, 0, 0, 0, 0, time.UTC)
+		return totalDays - (isoWeekday(last)-w.Weekday+7)%7, true
+	}
+
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	day := 1 + (w.Weekday-isoWeekday(first)+7)%7 + 7*(w.Ordinal-1)
+	return day, day <= totalDays
+}
+
+// String returns the weekday in the "<ordinal>:<weekday>" form.
+func (w OrdinalWeekday) String() string {
+	return strconv.Itoa(w.Ordinal) + ":" + strconv.Itoa(w.Weekday)
+}
+
+// Monthly repeats a task on the listed days of month ("m <days> [months]").
+// Days -1 and -2 stand for the last and the penultimate day of a month,
+// Weekdays select days like "the second Tuesday" or "the last Friday".
+// An empty Months list allows every month.
+type Monthly struct {
+	Days     []int
+	Weekdays []OrdinalWeekday
+	Months   []int
+}
+
+// Next implements Rule.
+func (r Monthly) Next(now, date time.Time, cal Calendar) time.Time {
+	return shiftMonthly(now, r.Months, func(month time.Month, year int) []int {
+		return normalizeDays(r.Days, r.Weekdays, month, year)
+	})
+}
+
+// String implements Rule.
+func (r Monthly) String() string {
+	days := make([]string, 0, len(r.Days)+len(r.Weekdays))
+	if len(r.Days) > 0 {
+		days = append(days, joinInts(r.Days))
+	}
+	for _, weekday := range r.Weekdays {
+		days = append(days, weekday.String())
+	}
+
+	s := "m " + strings.Join(days, ",")
+	if len(r.Months) > 0 {
+		s += " " + joinInts(r.Months)
+	}
+	return s
+}
+
+// Yearly repeats a task on the same date every year ("y").
+type Yearly struct{}
+
+// Next implements Rule.
+func (r Yearly) Next(now, date time.Time, cal Calendar) time.Time {
+	return shiftYearly(now, date)
+}
+
+// String implements Rule.
+func (r Yearly) String() string {
+	return "y"
+}
+
+// Workdays repeats a task every Interval working days ("b <n>").
+type Workdays struct {
+	Interval int
+}
+
+// Next implements Rule.
+func (r Workdays) Next(now, date time.Time, cal Calendar) time.Time {
+	next := addWorkdays(date, r.Interval, cal)
+	for !next.After(now) {
+		next = addWorkdays(next, r.Interval, cal)
+	}
+	return next
+}
+
+// String implements Rule.
+func (r Workdays) String() string {
+	return "b " + strconv.Itoa(r.Interval)
+}
+
+// MonthlyWorkdays repeats a task on the N-th working days of a month ("bm <days> [months]").
+// Negative days count from the end of a month, -1 is the last working day.
+// An empty Months list allows every month.
+type MonthlyWorkdays struct {
+	Days   []int
+	Months []int
+}
+
+// Next implements Rule.
+func (r MonthlyWorkdays) Next(now, date time.Time, cal Calendar) time.Time {
+	return shiftMonthly(now, r.Months, func(month time.Month, year int) []int {
+		return workdaysOfMonth(r.Days, month, year, cal)
+	})
+}
+
+// String implements Rule.
+func (r MonthlyWorkdays) String() string {
+	s := "bm " + joinInts(r.Days)
+	if len(r.Months) > 0 {
+		s += " " + joinInts(r.Months)
+	}
+	return s
+}
+
+// Shift is the direction in which an occurrence on a non-working day is moved.
+type Shift int
+
+const (
+	ShiftPrev Shift = -1 // Move to the previous working day ("shift=prev")
+	ShiftNext Shift = 1  // Move to the next working day ("shift=next")
+)
+
+// String returns the modifier value of the shift.
+func (s Shift) String() string {
+	if s == ShiftPrev {
+		return "prev"
+	}
+	return "next"
+}
+
+// Shifted moves occurrences of Rule which fall on weekends or holidays
+// to the closest working day in the given direction ("<rule> shift=next").
+type Shifted struct {
+	Rule      Rule
+	Direction Shift
+}
+
+// Next implements Rule.
+func (r Shifted) Next(now, date time.Time, cal Calendar) time.Time {
+	next := r.Rule.Next(now, date, cal)
+	for !next.IsZero() {
+		shifted := toWorkday(next, r.Direction, cal)
+		if shifted.After(now) {
+			return shifted
+		}
+		next = r.Rule.Next(next, date, cal)
+	}
+	return next
+}
+
+// String implements Rule.
+func (r Shifted) String() string {
+	return r.Rule.String() + " shift=" + r.Direction.String()
+}
+
+// Limited ends Rule after the Until date or after Count occurrences
+// ("<rule> until=YYYYMMDD", "<rule> count=N"). Zero values mean no limit.
+type Limited struct {
+	Rule  Rule
+	Until time.Time
+	Count int
+}
+
+// Next implements Rule.
+// It returns the zero time once the next occurrence would fall after Until.
+func (r Limited) Next(now, date time.Time, cal Calendar) time.Time {
+	next := r.Rule.Next(now, date, cal)
+	if !r.Until.IsZero() && next.After(r.Until) {
+		return time.Time{}
+	}
+	return next
+}
+
+// String implements Rule.
+func (r Limited) String() string {
+	s := r.Rule.String()
+	if !r.Until.IsZero() {
+		s += " until=" + r.Until.Format(lib.DateFormat)
+	}
+	if r.Count > 0 {
+		s += " count=" + strconv.Itoa(r.Count)
+	}
+	return s
+}
+
+// Exhausted reports whether the occurrence with the given 1-based number is the last one allowed by
+// the count limit of the rule. Rules without a count limit are never exhausted.
+func Exhausted(rule Rule, occurrence int) bool {
+	limited, ok := rule.(Limited)
+	return ok && limited.Count > 0 && occurrence >= limited.Count
+}
+
+func joinInts(values []int) string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = strconv.Itoa(v)
+	}
+	return strings.Join(strs, ",")
+}
@@ -0,0 +1,68 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib"
+	"github.com/10Narratives/task-tracker/internal/lib/identity"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// systemActor names the application in the audit log for changes made without a user, such as by the workers.
+const systemActor = "system"
+
+// audit appends a change of the task with the given ID to the audit log, naming the user carried by ctx as its actor.
+// The state after the change is read back from the storage, so it is called once the change is made
+// with the same context to take part in its transaction. The after snapshot is left out if the task went to the trash.
+func (service TaskService) audit(ctx context.Context, operation string, id int64, before *models.Task) error {
+	entry := models.AuditEntry{
+		Actor:     systemActor,
+		Operation: operation,
+		TaskID:    id,
+		Before:    before,
+		CreatedAt: service.clock.Now().UTC().Format(time.RFC3339),
+	}
+	if user, ok := identity.FromContext(ctx); ok {
+		entry.Actor = user.Name
+	}
+
+	after, err := service.storage.Read(ctx, id)
+	switch {
+	case err == nil:
+		entry.After = &after
+	case !errors.Is(err, domain.ErrNotFound):
+		return err
+	}
+
+	_, err = service.storage.CreateAuditEntry(ctx, entry)
+	return err
+}
+
+// AuditLog returns a page of the audit log, most recent changes first.
+// The from and to dates are in YYYYMMDD format, evaluated in the time zone carried by ctx or in the default one,
+// and restrict the page to the changes made between them inclusive. An empty date leaves the range open on its side.
+// The other fields of the filter are passed to the storage as they are.
+func (service TaskService) AuditLog(ctx context.Context, from, to string, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	loc := service.userLocation(ctx)
+
+	if from != "" {
+		start, err := time.ParseInLocation(lib.DateFormat, from, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date %q: %w", from, err)
+		}
+		filter.From = start.UTC().Format(time.RFC3339)
+	}
+	if to != "" {
+		end, err := time.ParseInLocation(lib.DateFormat, to, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date %q: %w", to, err)
+		}
+		filter.To = end.AddDate(0, 0, 1).UTC().Format(time.RFC3339)
+	}
+
+	return service.storage.ReadAuditEntries(ctx, filter)
+}
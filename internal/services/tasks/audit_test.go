@@ -0,0 +1,149 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib/identity"
+	"github.com/10Narratives/task-tracker/internal/lib/timezone"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskService_Update_Audit(t *testing.T) {
+	clock := fixedClock(time.Date(2025, 4, 10, 10, 30, 0, 0, time.UTC))
+	before := models.Task{ID: 7, Date: "20250410", Title: "Water plants", Anchor: models.AnchorDue, Occurrence: 1, Priority: 3, Status: models.StatusTodo, Version: 1}
+	after := before
+	after.Title = "Water the plants"
+	after.Version = 2
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		auditErr  error
+		wantActor string
+		wantErr   string
+	}{
+		{
+			name:      "change made by a user",
+			ctx:       identity.WithUser(context.Background(), identity.User{Name: "alice"}),
+			wantActor: "alice",
+		},
+		{
+			name:      "change made without a user",
+			ctx:       context.Background(),
+			wantActor: "system",
+		},
+		{
+			name:      "audit entry cannot be written",
+			ctx:       context.Background(),
+			auditErr:  errors.New("database error"),
+			wantActor: "system",
+			wantErr:   "database error",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			storage.On("Read", mock.Anything, int64(7)).Return(before, nil).Once()
+			storage.On("Update", mock.Anything, mock.Anything).Return(nil)
+			storage.On("CreateOperation", mock.Anything, mock.Anything).Return(int64(1), nil)
+			storage.On("DeleteOperations", mock.Anything, mock.Anything).Return(nil)
+			storage.On("Read", mock.Anything, int64(7)).Return(after, nil).Once()
+			storage.
+				On("CreateAuditEntry", mock.Anything, models.AuditEntry{
+					Actor:     tc.wantActor,
+					Operation: models.OperationUpdate,
+					TaskID:    7,
+					Before:    &before,
+					After:     &after,
+					CreatedAt: "2025-04-10T10:30:00Z",
+				}).
+				Return(int64(1), tc.auditErr)
+
+			service := tasks.New(storage, tasks.WithClock(clock))
+			err := service.Update(tc.ctx, models.Task{ID: 7, Date: "20250410", Title: "Water the plants"})
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTaskService_AuditLog(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		from       string
+		to         string
+		filter     models.AuditFilter
+		wantFilter models.AuditFilter
+		wantErr    string
+	}{
+		{
+			name:       "whole log",
+			ctx:        context.Background(),
+			wantFilter: models.AuditFilter{},
+		},
+		{
+			name:       "filter is passed on",
+			ctx:        context.Background(),
+			filter:     models.AuditFilter{Actor: "alice", Operation: models.OperationDelete, TaskID: 7, Limit: 10, Offset: 20},
+			wantFilter: models.AuditFilter{Actor: "alice", Operation: models.OperationDelete, TaskID: 7, Limit: 10, Offset: 20},
+		},
+		{
+			name:       "dates are whole days in the request zone",
+			ctx:        timezone.WithLocation(context.Background(), tokyo),
+			from:       "20250407",
+			to:         "20250407",
+			wantFilter: models.AuditFilter{From: "2025-04-06T15:00:00Z", To: "2025-04-07T15:00:00Z"},
+		},
+		{
+			name:    "invalid to date",
+			ctx:     context.Background(),
+			to:      "07.04.2025",
+			wantErr: `invalid to date "07.04.2025"`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			entries := []models.AuditEntry{{ID: 1, Actor: "alice", Operation: models.OperationRegister, TaskID: 7}}
+			storage := mocks.NewTaskStorage(t)
+			if tc.wantErr == "" {
+				storage.
+					On("ReadAuditEntries", mock.Anything, tc.wantFilter).
+					Return(entries, nil)
+			}
+
+			service := tasks.New(storage)
+			got, err := service.AuditLog(tc.ctx, tc.from, tc.to, tc.filter)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entries, got)
+		})
+	}
+}
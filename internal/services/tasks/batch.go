@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// ErrBatchFailed is returned when an operation of a batch fails. None of the operations are applied in that case.
+var ErrBatchFailed = domain.New(domain.ErrConflict, "batch was rolled back")
+
+// ErrUnknownAction is returned for a batch operation with an action which is not supported.
+var ErrUnknownAction = domain.New(domain.ErrInvalidRule, "unknown batch action")
+
+// Batch applies the operations in the given order in a single transaction.
+// Every operation is attempted, so the results report all the operations which failed and why.
+// If any of them fails, the whole batch is rolled back and ErrBatchFailed is returned together with the results.
+// Each applied operation can be undone on its own, the most recent first.
+// There is no bulk write in the storage, so every operation costs what the single call does:
+// a read of the task, its writes, the undo record and the audit entry, which reads the task back once more.
+func (service TaskService) Batch(ctx context.Context, ops []models.BatchOperation) ([]models.BatchResult, error) {
+	results := make([]models.BatchResult, len(ops))
+	err := service.storage.InTx(ctx, func(ctx context.Context) error {
+		failed := 0
+		for i, op := range ops {
+			results[i] = models.BatchResult{ID: op.ID, Action: op.Action}
+			if err := service.apply(ctx, op); err != nil {
+				results[i].Err = err.Error()
+				failed++
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf("%w: %d of %d operations failed", ErrBatchFailed, failed, len(ops))
+		}
+		return nil
+	})
+	return results, err
+}
+
+// apply runs a single batch operation through the method doing the same for a single task,
+// which reads the task and checks its version. It returns ErrTaskNotFound if there is no task with the ID
+// and ErrVersionMismatch if the operation is based on a version other than the current one.
+func (service TaskService) apply(ctx context.Context, op models.BatchOperation) error {
+	switch op.Action {
+	case models.BatchComplete:
+		return service.Complete(ctx, op.ID, op.Version, op.Force)
+	case models.BatchDelete:
+		return service.Delete(ctx, op.ID, op.Version)
+	case models.BatchUpdate:
+		op.Task.ID = op.ID
+		op.Task.Version = op.Version
+		return service.Update(ctx, op.Task)
+	case models.BatchMoveDate:
+		task, err := service.read(ctx, op.ID)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(task, op.Version); err != nil {
+			return err
+		}
+		return service.moveDate(ctx, task, op.Date)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownAction, op.Action)
+	}
+}
+
+// moveDate puts a task on another date keeping the rest of its details. The move can be undone.
+func (service TaskService) moveDate(ctx context.Context, task models.Task, date string) error {
+	if task.Date == date {
+		return nil
+	}
+
+	moved := task
+	moved.Date = date
+	if err := service.write(ctx, &moved); err != nil {
+		return err
+	}
+	if err := service.record(ctx, models.Operation{Kind: models.OperationUpdate, TaskID: task.ID, Task: &task}); err != nil {
+		return err
+	}
+	return service.audit(ctx, models.OperationUpdate, task.ID, &task)
+}
@@ -0,0 +1,94 @@
+package tasks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskService_Batch(t *testing.T) {
+	var (
+		laundry = models.Task{ID: 4, Date: "20240203", Title: "Laundry", Anchor: models.AnchorDue, Priority: models.PriorityNormal, Status: models.StatusTodo}
+		plants  = models.Task{ID: 5, Date: "20240201", Title: "Water plants", Repeat: "d 2", Anchor: models.AnchorDue, Occurrence: 1, Priority: models.PriorityNormal, Status: models.StatusTodo, Version: 1}
+	)
+
+	tests := []struct {
+		name        string
+		ops         []models.BatchOperation
+		mockSetup   func(m *mocks.TaskStorage)
+		wantResults []models.BatchResult
+		wantErr     require.ErrorAssertionFunc
+	}{
+		{
+			name: "operations are applied",
+			ops: []models.BatchOperation{
+				{Action: models.BatchDelete, ID: 4},
+				{Action: models.BatchMoveDate, ID: 5, Date: "20240210"},
+			},
+			mockSetup: func(m *mocks.TaskStorage) {
+				// Each task is read once by the operation and once more for the audit log.
+				m.On("Read", mock.Anything, int64(4)).Return(laundry, nil).Twice()
+				m.On("Delete", mock.Anything, int64(4)).Return(nil).Once()
+				m.On("Read", mock.Anything, int64(5)).Return(plants, nil).Twice()
+				moved := plants
+				moved.Date = "20240210"
+				m.On("Update", mock.Anything, &moved).Return(nil).Once()
+				recordsOperation(m)
+			},
+			wantResults: []models.BatchResult{
+				{ID: 4, Action: models.BatchDelete},
+				{ID: 5, Action: models.BatchMoveDate},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "failed operations are reported and the batch is rolled back",
+			ops: []models.BatchOperation{
+				{Action: models.BatchDelete, ID: 4},
+				{Action: models.BatchComplete, ID: 9},
+				{Action: "archive", ID: 5},
+				{Action: models.BatchMoveDate, ID: 5, Date: "20240210", Version: 2},
+			},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(4)).Return(laundry, nil)
+				m.On("Delete", mock.Anything, int64(4)).Return(nil).Once()
+				recordsOperation(m)
+				m.On("Read", mock.Anything, int64(9)).Return(models.Task{}, errNotFound)
+				m.On("Read", mock.Anything, int64(5)).Return(plants, nil)
+			},
+			wantResults: []models.BatchResult{
+				{ID: 4, Action: models.BatchDelete},
+				{ID: 9, Action: models.BatchComplete, Err: "task not found: 9"},
+				{ID: 5, Action: "archive", Err: `unknown batch action: "archive"`},
+				{ID: 5, Action: models.BatchMoveDate, Err: "task was changed in the meantime: version 2 is not the current version 1"},
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, tasks.ErrBatchFailed, i...)
+				assert.EqualError(tt, err, "batch was rolled back: 3 of 4 operations failed", i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage)
+			results, err := service.Batch(context.Background(), tc.ops)
+			tc.wantErr(t, err)
+			assert.Equal(t, tc.wantResults, results)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// ErrDependencyCycle is returned when a dependency would make a task wait for itself.
+var ErrDependencyCycle = domain.New(domain.ErrConflict, "dependency would create a cycle")
+
+// ErrBlocked is returned when a task is completed while some of its blockers are still open.
+var ErrBlocked = domain.New(domain.ErrConflict, "task is blocked by open tasks")
+
+// checkBlockers returns ErrBlocked with the IDs of the open blockers of the task, if there are any.
+func (service TaskService) checkBlockers(ctx context.Context, id int64) error {
+	blockers, err := service.storage.OpenBlockers(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(blockers) > 0 {
+		return fmt.Errorf("%w: %v", ErrBlocked, blockers)
+	}
+	return nil
+}
+
+// AddDependency makes the task with the ID wait until the blocker is done.
+// It returns ErrTaskNotFound if either task does not exist
+// and ErrDependencyCycle if the blocker already waits for the task, directly or through other tasks.
+func (service TaskService) AddDependency(ctx context.Context, id, blockerID int64) error {
+	if id == blockerID {
+		return ErrDependencyCycle
+	}
+
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		for _, taskID := range []int64{id, blockerID} {
+			if _, err := service.read(ctx, taskID); err != nil {
+				return err
+			}
+		}
+
+		cycle, err := service.storage.DependsOn(ctx, blockerID, id)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return ErrDependencyCycle
+		}
+
+		return service.storage.AddDependency(ctx, id, blockerID)
+	})
+}
+
+// RemoveDependency stops the task with the ID from waiting for the blocker.
+// The error is of the domain.ErrNotFound kind if the task does not wait for the blocker.
+func (service TaskService) RemoveDependency(ctx context.Context, id, blockerID int64) error {
+	return service.storage.RemoveDependency(ctx, id, blockerID)
+}
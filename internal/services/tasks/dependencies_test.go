@@ -0,0 +1,129 @@
+package tasks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTaskService_AddDependency(t *testing.T) {
+	tests := []struct {
+		name      string
+		blockerID int64
+		mockSetup func(m *mocks.TaskStorage)
+		wantErr   error
+	}{
+		{
+			name:      "dependency is added",
+			blockerID: 4,
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", mock.Anything, int64(7)).Return(models.Task{ID: 7, Title: "Paint"}, nil)
+				m.On("Read", mock.Anything, int64(4)).Return(models.Task{ID: 4, Title: "Buy paint"}, nil)
+				m.On("DependsOn", mock.Anything, int64(4), int64(7)).Return(false, nil)
+				m.On("AddDependency", mock.Anything, int64(7), int64(4)).Return(nil)
+			},
+		},
+		{
+			name:      "blocker does not exist",
+			blockerID: 4,
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", mock.Anything, int64(7)).Return(models.Task{ID: 7, Title: "Paint"}, nil)
+				m.On("Read", mock.Anything, int64(4)).Return(models.Task{}, errNotFound)
+			},
+			wantErr: tasks.ErrTaskNotFound,
+		},
+		{
+			name:      "blocker waits for the task",
+			blockerID: 4,
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", mock.Anything, int64(7)).Return(models.Task{ID: 7, Title: "Paint"}, nil)
+				m.On("Read", mock.Anything, int64(4)).Return(models.Task{ID: 4, Title: "Buy paint"}, nil)
+				m.On("DependsOn", mock.Anything, int64(4), int64(7)).Return(true, nil)
+			},
+			wantErr: tasks.ErrDependencyCycle,
+		},
+		{
+			name:      "task blocks itself",
+			blockerID: 7,
+			mockSetup: func(m *mocks.TaskStorage) {},
+			wantErr:   tasks.ErrDependencyCycle,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage)
+			assert.ErrorIs(t, service.AddDependency(context.Background(), 7, tc.blockerID), tc.wantErr)
+		})
+	}
+}
+
+func TestTaskService_Complete_Blocked(t *testing.T) {
+	task := models.Task{ID: 7, Date: "20250410", Title: "Paint", BlockedBy: []int64{2, 4}}
+
+	tests := []struct {
+		name      string
+		force     bool
+		mockSetup func(m *mocks.TaskStorage)
+		wantErr   error
+	}{
+		{
+			name: "blocked task is not completed",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(7)).Return(task, nil)
+				m.On("OpenBlockers", mock.Anything, int64(7)).Return([]int64{4}, nil)
+			},
+			wantErr: tasks.ErrBlocked,
+		},
+		{
+			name: "task with done blockers is completed",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(7)).Return(task, nil)
+				m.On("OpenBlockers", mock.Anything, int64(7)).Return([]int64(nil), nil)
+				m.On("CreateCompletion", mock.Anything, mock.Anything).Return(int64(1), nil)
+				recordsOperation(m)
+				m.On("Delete", mock.Anything, int64(7)).Return(nil)
+			},
+		},
+		{
+			name:  "forced completion ignores blockers",
+			force: true,
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(7)).Return(task, nil)
+				m.On("CreateCompletion", mock.Anything, mock.Anything).Return(int64(1), nil)
+				recordsOperation(m)
+				m.On("Delete", mock.Anything, int64(7)).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage)
+			assert.ErrorIs(t, service.Complete(context.Background(), 7, 0, tc.force), tc.wantErr)
+		})
+	}
+}
@@ -14,27 +14,579 @@ type TaskStorage struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, date, title, comment, repeat
-func (_m *TaskStorage) Create(ctx context.Context, date string, title string, comment string, repeat string) (int64, error) {
-	ret := _m.Called(ctx, date, title, comment, repeat)
+// AddDependency provides a mock function with given fields: ctx, taskID, blockerID
+func (_m *TaskStorage) AddDependency(ctx context.Context, taskID int64, blockerID int64) error {
+	ret := _m.Called(ctx, taskID, blockerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, taskID, blockerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: ctx, task
+func (_m *TaskStorage) Create(ctx context.Context, task models.Task) (int64, error) {
+	ret := _m.Called(ctx, task)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
-	var r0 int64
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Task) (int64, error)); ok {
+		return rf(ctx, task)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Task) int64); ok {
+		r0 = rf(ctx, task)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Task) error); ok {
+		r1 = rf(ctx, task)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAuditEntry provides a mock function with given fields: ctx, entry
+func (_m *TaskStorage) CreateAuditEntry(ctx context.Context, entry models.AuditEntry) (int64, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEntry")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditEntry) (int64, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditEntry) int64); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.AuditEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCompletion provides a mock function with given fields: ctx, completion
+func (_m *TaskStorage) CreateCompletion(ctx context.Context, completion models.Completion) (int64, error) {
+	ret := _m.Called(ctx, completion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCompletion")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Completion) (int64, error)); ok {
+		return rf(ctx, completion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Completion) int64); ok {
+		r0 = rf(ctx, completion)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Completion) error); ok {
+		r1 = rf(ctx, completion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOperation provides a mock function with given fields: ctx, op
+func (_m *TaskStorage) CreateOperation(ctx context.Context, op models.Operation) (int64, error) {
+	ret := _m.Called(ctx, op)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOperation")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Operation) (int64, error)); ok {
+		return rf(ctx, op)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Operation) int64); ok {
+		r0 = rf(ctx, op)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Operation) error); ok {
+		r1 = rf(ctx, op)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateProject provides a mock function with given fields: ctx, p
+func (_m *TaskStorage) CreateProject(ctx context.Context, p models.Project) (int64, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProject")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Project) (int64, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Project) int64); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Project) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSentReminder provides a mock function with given fields: ctx, r, sentAt
+func (_m *TaskStorage) CreateSentReminder(ctx context.Context, r models.Reminder, sentAt string) error {
+	ret := _m.Called(ctx, r, sentAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSentReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Reminder, string) error); ok {
+		r0 = rf(ctx, r, sentAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTag provides a mock function with given fields: ctx, name
+func (_m *TaskStorage) CreateTag(ctx context.Context, name string) (int64, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *TaskStorage) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCompletion provides a mock function with given fields: ctx, id
+func (_m *TaskStorage) DeleteCompletion(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCompletion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOperation provides a mock function with given fields: ctx, id
+func (_m *TaskStorage) DeleteOperation(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOperation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOperations provides a mock function with given fields: ctx, before
+func (_m *TaskStorage) DeleteOperations(ctx context.Context, before string) error {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOperations")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProject provides a mock function with given fields: ctx, id
+func (_m *TaskStorage) DeleteProject(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSentReminders provides a mock function with given fields: ctx, before
+func (_m *TaskStorage) DeleteSentReminders(ctx context.Context, before string) error {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSentReminders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DependsOn provides a mock function with given fields: ctx, taskID, blockerID
+func (_m *TaskStorage) DependsOn(ctx context.Context, taskID int64, blockerID int64) (bool, error) {
+	ret := _m.Called(ctx, taskID, blockerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DependsOn")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return rf(ctx, taskID, blockerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, taskID, blockerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, taskID, blockerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InTx provides a mock function with given fields: ctx, fn
+func (_m *TaskStorage) InTx(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LastOperation provides a mock function with given fields: ctx, since
+func (_m *TaskStorage) LastOperation(ctx context.Context, since string) (models.Operation, error) {
+	ret := _m.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastOperation")
+	}
+
+	var r0 models.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Operation, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Operation); ok {
+		r0 = rf(ctx, since)
+	} else {
+		r0 = ret.Get(0).(models.Operation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeTag provides a mock function with given fields: ctx, from, into
+func (_m *TaskStorage) MergeTag(ctx context.Context, from int64, into int64) error {
+	ret := _m.Called(ctx, from, into)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, from, into)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenBlockers provides a mock function with given fields: ctx, taskID
+func (_m *TaskStorage) OpenBlockers(ctx context.Context, taskID int64) ([]int64, error) {
+	ret := _m.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenBlockers")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]int64, error)); ok {
+		return rf(ctx, taskID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = rf(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Purge provides a mock function with given fields: ctx, before
+func (_m *TaskStorage) Purge(ctx context.Context, before string) (int64, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Read provides a mock function with given fields: ctx, id
+func (_m *TaskStorage) Read(ctx context.Context, id int64) (models.Task, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 models.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (models.Task, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) models.Task); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadAuditEntries provides a mock function with given fields: ctx, filter
+func (_m *TaskStorage) ReadAuditEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAuditEntries")
+	}
+
+	var r0 []models.AuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditFilter) ([]models.AuditEntry, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditFilter) []models.AuditEntry); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.AuditFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadByDate provides a mock function with given fields: ctx, date, filter
+func (_m *TaskStorage) ReadByDate(ctx context.Context, date string, filter models.TaskFilter) ([]models.Task, error) {
+	ret := _m.Called(ctx, date, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadByDate")
+	}
+
+	var r0 []models.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.TaskFilter) ([]models.Task, error)); ok {
+		return rf(ctx, date, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.TaskFilter) []models.Task); ok {
+		r0 = rf(ctx, date, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.TaskFilter) error); ok {
+		r1 = rf(ctx, date, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadByPayload provides a mock function with given fields: ctx, payload, filter
+func (_m *TaskStorage) ReadByPayload(ctx context.Context, payload string, filter models.TaskFilter) ([]models.Task, error) {
+	ret := _m.Called(ctx, payload, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadByPayload")
+	}
+
+	var r0 []models.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (int64, error)); ok {
-		return rf(ctx, date, title, comment, repeat)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.TaskFilter) ([]models.Task, error)); ok {
+		return rf(ctx, payload, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) int64); ok {
-		r0 = rf(ctx, date, title, comment, repeat)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.TaskFilter) []models.Task); ok {
+		r0 = rf(ctx, payload, filter)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Task)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
-		r1 = rf(ctx, date, title, comment, repeat)
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.TaskFilter) error); ok {
+		r1 = rf(ctx, payload, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,45 +594,59 @@ func (_m *TaskStorage) Create(ctx context.Context, date string, title string, co
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *TaskStorage) Delete(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// ReadChildren provides a mock function with given fields: ctx, parentID
+func (_m *TaskStorage) ReadChildren(ctx context.Context, parentID int64) ([]models.Task, error) {
+	ret := _m.Called(ctx, parentID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for ReadChildren")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []models.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.Task, error)); ok {
+		return rf(ctx, parentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.Task); ok {
+		r0 = rf(ctx, parentID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Task)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Read provides a mock function with given fields: ctx, id
-func (_m *TaskStorage) Read(ctx context.Context, id int64) (models.Task, error) {
-	ret := _m.Called(ctx, id)
+// ReadCompletions provides a mock function with given fields: ctx, filter
+func (_m *TaskStorage) ReadCompletions(ctx context.Context, filter models.CompletionFilter) ([]models.Completion, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Read")
+		panic("no return value specified for ReadCompletions")
 	}
 
-	var r0 models.Task
+	var r0 []models.Completion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (models.Task, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CompletionFilter) ([]models.Completion, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) models.Task); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CompletionFilter) []models.Completion); ok {
+		r0 = rf(ctx, filter)
 	} else {
-		r0 = ret.Get(0).(models.Task)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Completion)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CompletionFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -88,21 +654,51 @@ func (_m *TaskStorage) Read(ctx context.Context, id int64) (models.Task, error)
 	return r0, r1
 }
 
-// ReadByDate provides a mock function with given fields: ctx, date
-func (_m *TaskStorage) ReadByDate(ctx context.Context, date string) ([]models.Task, error) {
-	ret := _m.Called(ctx, date)
+// ReadGroup provides a mock function with given fields: ctx, filter
+func (_m *TaskStorage) ReadGroup(ctx context.Context, filter models.TaskFilter) ([]models.Task, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadByDate")
+		panic("no return value specified for ReadGroup")
+	}
+
+	var r0 []models.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.TaskFilter) ([]models.Task, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.TaskFilter) []models.Task); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.TaskFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadOverdue provides a mock function with given fields: ctx, before
+func (_m *TaskStorage) ReadOverdue(ctx context.Context, before string) ([]models.Task, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOverdue")
 	}
 
 	var r0 []models.Task
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Task, error)); ok {
-		return rf(ctx, date)
+		return rf(ctx, before)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Task); ok {
-		r0 = rf(ctx, date)
+		r0 = rf(ctx, before)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Task)
@@ -110,7 +706,7 @@ func (_m *TaskStorage) ReadByDate(ctx context.Context, date string) ([]models.Ta
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, date)
+		r1 = rf(ctx, before)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -118,29 +714,117 @@ func (_m *TaskStorage) ReadByDate(ctx context.Context, date string) ([]models.Ta
 	return r0, r1
 }
 
-// ReadByPayload provides a mock function with given fields: ctx, payload
-func (_m *TaskStorage) ReadByPayload(ctx context.Context, payload string) ([]models.Task, error) {
-	ret := _m.Called(ctx, payload)
+// ReadProject provides a mock function with given fields: ctx, id
+func (_m *TaskStorage) ReadProject(ctx context.Context, id int64) (models.Project, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadByPayload")
+		panic("no return value specified for ReadProject")
+	}
+
+	var r0 models.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (models.Project, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) models.Project); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadProjects provides a mock function with given fields: ctx
+func (_m *TaskStorage) ReadProjects(ctx context.Context) ([]models.Project, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadProjects")
+	}
+
+	var r0 []models.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Project, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Project); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadRemindedTasks provides a mock function with given fields: ctx
+func (_m *TaskStorage) ReadRemindedTasks(ctx context.Context) ([]models.Task, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadRemindedTasks")
 	}
 
 	var r0 []models.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Task, error)); ok {
-		return rf(ctx, payload)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Task, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Task); ok {
-		r0 = rf(ctx, payload)
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Task); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Task)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadSentReminders provides a mock function with given fields: ctx, since
+func (_m *TaskStorage) ReadSentReminders(ctx context.Context, since string) ([]models.Reminder, error) {
+	ret := _m.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSentReminders")
+	}
+
+	var r0 []models.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Reminder, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Reminder); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reminder)
+		}
+	}
+
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, payload)
+		r1 = rf(ctx, since)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -148,12 +832,42 @@ func (_m *TaskStorage) ReadByPayload(ctx context.Context, payload string) ([]mod
 	return r0, r1
 }
 
-// ReadGroup provides a mock function with given fields: ctx
-func (_m *TaskStorage) ReadGroup(ctx context.Context) ([]models.Task, error) {
+// ReadTags provides a mock function with given fields: ctx
+func (_m *TaskStorage) ReadTags(ctx context.Context) ([]models.Tag, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadGroup")
+		panic("no return value specified for ReadTags")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Tag, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Tag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadTrash provides a mock function with given fields: ctx
+func (_m *TaskStorage) ReadTrash(ctx context.Context) ([]models.Task, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTrash")
 	}
 
 	var r0 []models.Task
@@ -178,6 +892,124 @@ func (_m *TaskStorage) ReadGroup(ctx context.Context) ([]models.Task, error) {
 	return r0, r1
 }
 
+// RemoveDependency provides a mock function with given fields: ctx, taskID, blockerID
+func (_m *TaskStorage) RemoveDependency(ctx context.Context, taskID int64, blockerID int64) error {
+	ret := _m.Called(ctx, taskID, blockerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, taskID, blockerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RenameTag provides a mock function with given fields: ctx, id, name
+func (_m *TaskStorage) RenameTag(ctx context.Context, id int64, name string) error {
+	ret := _m.Called(ctx, id, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetChildren provides a mock function with given fields: ctx, parentID, date
+func (_m *TaskStorage) ResetChildren(ctx context.Context, parentID int64, date string) error {
+	ret := _m.Called(ctx, parentID, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetChildren")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, parentID, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *TaskStorage) Restore(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTags provides a mock function with given fields: ctx, taskID, names
+func (_m *TaskStorage) SetTags(ctx context.Context, taskID int64, names []string) error {
+	ret := _m.Called(ctx, taskID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) error); ok {
+		r0 = rf(ctx, taskID, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagID provides a mock function with given fields: ctx, name
+func (_m *TaskStorage) TagID(ctx context.Context, name string) (int64, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, t
 func (_m *TaskStorage) Update(ctx context.Context, t *models.Task) error {
 	ret := _m.Called(ctx, t)
@@ -196,6 +1028,24 @@ func (_m *TaskStorage) Update(ctx context.Context, t *models.Task) error {
 	return r0
 }
 
+// UpdateProject provides a mock function with given fields: ctx, p
+func (_m *TaskStorage) UpdateProject(ctx context.Context, p models.Project) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Project) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTaskStorage creates a new instance of TaskStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTaskStorage(t interface {
@@ -0,0 +1,92 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// ErrProjectNotFound is returned when there is no project with the given ID.
+var ErrProjectNotFound = domain.New(domain.ErrNotFound, "project not found")
+
+// ErrProjectArchived is returned when a task is added to an archived project.
+var ErrProjectArchived = domain.New(domain.ErrConflict, "project is archived")
+
+// checkProject makes sure that tasks can be added to the project with the ID. Zero stands for no project.
+func (service TaskService) checkProject(ctx context.Context, id int64) error {
+	if id == 0 {
+		return nil
+	}
+
+	project, err := service.storage.ReadProject(ctx, id)
+	if err != nil {
+		return projectNotFound(err, id)
+	}
+	if project.Archived {
+		return ErrProjectArchived
+	}
+	return nil
+}
+
+// CreateProject adds a new project.
+// It returns the ID of the created project and any error encountered.
+func (service TaskService) CreateProject(ctx context.Context, project models.Project) (int64, error) {
+	return service.storage.CreateProject(ctx, project)
+}
+
+// Projects retrieves all projects, active ones first, in alphabetical order.
+func (service TaskService) Projects(ctx context.Context) ([]models.Project, error) {
+	return service.storage.ReadProjects(ctx)
+}
+
+// UpdateProject changes the name, colour and archive state of a project.
+// It returns ErrProjectNotFound if there is no project with the ID.
+func (service TaskService) UpdateProject(ctx context.Context, project models.Project) error {
+	return projectNotFound(service.storage.UpdateProject(ctx, project), project.ID)
+}
+
+// DeleteProject removes a project. Its tasks are kept without a project.
+// It returns ErrProjectNotFound if there is no project with the ID.
+func (service TaskService) DeleteProject(ctx context.Context, id int64) error {
+	return projectNotFound(service.storage.DeleteProject(ctx, id), id)
+}
+
+// projectNotFound replaces a not found error of the storage with ErrProjectNotFound.
+func projectNotFound(err error, id int64) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return fmt.Errorf("%w: %d", ErrProjectNotFound, id)
+	}
+	return err
+}
+
+// Move puts a task into the project with the given ID, or takes it out of its project if the ID is zero.
+// The move can be undone. It returns ErrTaskNotFound if there is no task with the ID
+// and ErrProjectNotFound or ErrProjectArchived if the task cannot be added to the project.
+func (service TaskService) Move(ctx context.Context, id, projectID int64) error {
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		if err := service.checkProject(ctx, projectID); err != nil {
+			return err
+		}
+
+		task, err := service.read(ctx, id)
+		if err != nil {
+			return err
+		}
+		if task.ProjectID == projectID {
+			return nil
+		}
+
+		moved := task
+		moved.ProjectID = projectID
+		if err := service.write(ctx, &moved); err != nil {
+			return err
+		}
+		if err := service.record(ctx, models.Operation{Kind: models.OperationUpdate, TaskID: id, Task: &task}); err != nil {
+			return err
+		}
+		return service.audit(ctx, models.OperationUpdate, id, &task)
+	})
+}
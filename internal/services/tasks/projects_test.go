@@ -0,0 +1,106 @@
+package tasks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTaskService_UpdateProject(t *testing.T) {
+	project := models.Project{ID: 2, Name: "Garden", Archived: true}
+
+	storage := mocks.NewTaskStorage(t)
+	storage.On("UpdateProject", mock.Anything, project).Return(errNotFound)
+
+	service := tasks.New(storage)
+	assert.ErrorIs(t, service.UpdateProject(context.Background(), project), tasks.ErrProjectNotFound)
+}
+
+func TestTaskService_DeleteProject(t *testing.T) {
+	storage := mocks.NewTaskStorage(t)
+	storage.On("DeleteProject", mock.Anything, int64(2)).Return(nil)
+
+	service := tasks.New(storage)
+	assert.NoError(t, service.DeleteProject(context.Background(), 2))
+}
+
+func TestTaskService_Move(t *testing.T) {
+	task := models.Task{ID: 7, Date: "20250410", Title: "Dig", ProjectID: 1}
+
+	tests := []struct {
+		name      string
+		projectID int64
+		mockSetup func(m *mocks.TaskStorage)
+		wantErr   error
+	}{
+		{
+			name:      "task is moved to another project",
+			projectID: 2,
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadProject", mock.Anything, int64(2)).Return(models.Project{ID: 2, Name: "Garden"}, nil)
+				m.On("Read", mock.Anything, int64(7)).Return(task, nil)
+				m.On("Update", mock.Anything, &models.Task{ID: 7, Date: "20250410", Title: "Dig", ProjectID: 2}).Return(nil)
+				m.
+					On("CreateOperation", mock.Anything, mock.MatchedBy(func(op models.Operation) bool {
+						return op.Kind == models.OperationUpdate && op.Task.ProjectID == 1
+					})).
+					Return(int64(1), nil)
+				m.On("DeleteOperations", mock.Anything, mock.Anything).Return(nil)
+				m.On("CreateAuditEntry", mock.Anything, mock.Anything).Return(int64(1), nil)
+			},
+		},
+		{
+			name:      "task is taken out of its project",
+			projectID: 0,
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(7)).Return(task, nil)
+				m.On("Update", mock.Anything, &models.Task{ID: 7, Date: "20250410", Title: "Dig"}).Return(nil)
+				recordsOperation(m)
+			},
+		},
+		{
+			name:      "task already in the project",
+			projectID: 1,
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadProject", mock.Anything, int64(1)).Return(models.Project{ID: 1, Name: "Home"}, nil)
+				m.On("Read", mock.Anything, int64(7)).Return(task, nil)
+			},
+		},
+		{
+			name:      "project does not exist",
+			projectID: 2,
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadProject", mock.Anything, int64(2)).Return(models.Project{}, errNotFound)
+			},
+			wantErr: tasks.ErrProjectNotFound,
+		},
+		{
+			name:      "project is archived",
+			projectID: 2,
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadProject", mock.Anything, int64(2)).Return(models.Project{ID: 2, Name: "Garden", Archived: true}, nil)
+			},
+			wantErr: tasks.ErrProjectArchived,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage)
+			assert.ErrorIs(t, service.Move(context.Background(), 7, tc.projectID), tc.wantErr)
+		})
+	}
+}
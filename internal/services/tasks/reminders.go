@@ -0,0 +1,126 @@
+package tasks
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib"
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+// defaultReminderGrace is how late a reminder can still be sent unless WithReminderGrace says otherwise.
+const defaultReminderGrace = time.Hour
+
+// WithReminderGrace sets how late a reminder can still be sent, for example after a restart.
+// Reminders missed by more than that are dropped. An hour is used by default.
+func WithReminderGrace(grace time.Duration) Option {
+	return func(service *TaskService) {
+		service.reminderGrace = grace
+	}
+}
+
+// ownerLocation returns the time zone of the owner of a task, which is kept with the account,
+// or the default one if the owner has not chosen one or it cannot be loaded.
+// Reminders are sent without a request, so there is no time zone carried by ctx as userLocation expects.
+func (service TaskService) ownerLocation(task models.Task) *time.Location {
+	if task.OwnerTimezone == "" {
+		return service.location
+	}
+	loc, err := time.LoadLocation(task.OwnerTimezone)
+	if err != nil {
+		return service.location
+	}
+	return loc
+}
+
+// dueAt returns the moment a task is due in the time zone of its owner.
+// Tasks without a time of day are due at midnight.
+func (service TaskService) dueAt(task models.Task) (time.Time, error) {
+	clock := task.Time
+	if clock == "" {
+		clock = "00:00"
+	}
+	return time.ParseInLocation(lib.DateFormat+lib.TimeFormat, task.Date+clock, service.ownerLocation(task))
+}
+
+// PendingReminders retrieves the reminders which are due now and have not been sent yet,
+// including those missed by less than the grace period, in the order they are due.
+// It also returns the time of the next reminder after now, or the zero time if there is none.
+func (service TaskService) PendingReminders(ctx context.Context) ([]models.Reminder, time.Time, error) {
+	now := service.clock.Now().UTC()
+	since := now.Add(-service.reminderGrace)
+
+	tasks, err := service.storage.ReadRemindedTasks(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	sent, err := service.storage.ReadSentReminders(ctx, since.Format(time.RFC3339))
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	type key struct {
+		taskID   int64
+		remindAt string
+	}
+	isSent := make(map[key]bool, len(sent))
+	for _, r := range sent {
+		isSent[key{r.TaskID, r.RemindAt}] = true
+	}
+
+	var (
+		pending []models.Reminder
+		next    time.Time
+	)
+	for _, task := range tasks {
+		due, err := service.dueAt(task)
+		if err != nil {
+			// A task with a broken date must not hold back the reminders of other tasks.
+			continue
+		}
+
+		for _, offset := range task.Reminders {
+			remindAt := due.Add(-time.Duration(offset) * time.Minute).UTC()
+			if remindAt.After(now) {
+				if next.IsZero() || remindAt.Before(next) {
+					next = remindAt
+				}
+				continue
+			}
+			if !remindAt.After(since) {
+				continue
+			}
+
+			r := models.Reminder{
+				TaskID:   task.ID,
+				Title:    task.Title,
+				Offset:   offset,
+				DueAt:    due.UTC().Format(time.RFC3339),
+				RemindAt: remindAt.Format(time.RFC3339),
+			}
+			if !isSent[key{r.TaskID, r.RemindAt}] {
+				pending = append(pending, r)
+			}
+		}
+	}
+
+	slices.SortStableFunc(pending, func(a, b models.Reminder) int {
+		return strings.Compare(a.RemindAt, b.RemindAt)
+	})
+	return pending, next, nil
+}
+
+// MarkReminderSent records that a reminder has been delivered so that it is not sent again
+// and forgets the reminders which are past the grace period.
+// It returns any error encountered.
+func (service TaskService) MarkReminderSent(ctx context.Context, r models.Reminder) error {
+	now := service.clock.Now().UTC()
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		if err := service.storage.CreateSentReminder(ctx, r, now.Format(time.RFC3339)); err != nil {
+			return err
+		}
+		return service.storage.DeleteSentReminders(ctx, now.Add(-service.reminderGrace).Format(time.RFC3339))
+	})
+}
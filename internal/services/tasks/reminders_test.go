@@ -0,0 +1,137 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskService_PendingReminders(t *testing.T) {
+	var (
+		clock = fixedClock(time.Date(2024, 2, 3, 14, 20, 0, 0, time.UTC))
+		since = "2024-02-03T13:20:00Z"
+	)
+
+	tests := []struct {
+		name          string
+		opts          []tasks.Option
+		mockSetup     func(m *mocks.TaskStorage)
+		wantReminders []models.Reminder
+		wantNext      time.Time
+		wantErr       require.ErrorAssertionFunc
+	}{
+		{
+			name: "due reminders are returned in order together with the next one",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadRemindedTasks", mock.Anything).Return([]models.Task{
+					{ID: 3, Date: "20240203", Time: "14:30", Title: "Dentist", Reminders: []int{90, 15, 5}},
+					{ID: 4, Date: "20240204", Title: "Rent", Reminders: []int{1440, 600}},
+				}, nil)
+				m.On("ReadSentReminders", mock.Anything, since).Return([]models.Reminder{}, nil)
+			},
+			wantReminders: []models.Reminder{
+				{TaskID: 4, Title: "Rent", Offset: 600, DueAt: "2024-02-04T00:00:00Z", RemindAt: "2024-02-03T14:00:00Z"},
+				{TaskID: 3, Title: "Dentist", Offset: 15, DueAt: "2024-02-03T14:30:00Z", RemindAt: "2024-02-03T14:15:00Z"},
+			},
+			wantNext: time.Date(2024, 2, 3, 14, 25, 0, 0, time.UTC),
+			wantErr:  require.NoError,
+		},
+		{
+			name: "sent reminders are skipped",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadRemindedTasks", mock.Anything).Return([]models.Task{
+					{ID: 3, Date: "20240203", Time: "14:30", Title: "Dentist", Reminders: []int{15}},
+				}, nil)
+				m.On("ReadSentReminders", mock.Anything, since).Return([]models.Reminder{{TaskID: 3, RemindAt: "2024-02-03T14:15:00Z"}}, nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "due time is taken in the default time zone for owners without one",
+			opts: []tasks.Option{tasks.WithLocation(time.FixedZone("UTC+3", 3*60*60))},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadRemindedTasks", mock.Anything).Return([]models.Task{
+					{ID: 3, Date: "20240203", Time: "17:30", Title: "Dentist", Reminders: []int{15}},
+				}, nil)
+				m.On("ReadSentReminders", mock.Anything, since).Return([]models.Reminder{}, nil)
+			},
+			wantReminders: []models.Reminder{
+				{TaskID: 3, Title: "Dentist", Offset: 15, DueAt: "2024-02-03T14:30:00Z", RemindAt: "2024-02-03T14:15:00Z"},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "due time is taken in the time zone of the owner",
+			opts: []tasks.Option{tasks.WithLocation(time.FixedZone("UTC+5", 5*60*60))},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadRemindedTasks", mock.Anything).Return([]models.Task{
+					{ID: 3, Date: "20240203", Time: "17:30", Title: "Dentist", Reminders: []int{15}, OwnerTimezone: "Europe/Moscow"},
+				}, nil)
+				m.On("ReadSentReminders", mock.Anything, since).Return([]models.Reminder{}, nil)
+			},
+			wantReminders: []models.Reminder{
+				{TaskID: 3, Title: "Dentist", Offset: 15, DueAt: "2024-02-03T14:30:00Z", RemindAt: "2024-02-03T14:15:00Z"},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "reminders missed by more than the grace period are dropped",
+			opts: []tasks.Option{tasks.WithReminderGrace(time.Minute)},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadRemindedTasks", mock.Anything).Return([]models.Task{
+					{ID: 3, Date: "20240203", Time: "14:30", Title: "Dentist", Reminders: []int{15}},
+				}, nil)
+				m.On("ReadSentReminders", mock.Anything, "2024-02-03T14:19:00Z").Return([]models.Reminder{}, nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadRemindedTasks", mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.EqualError(tt, err, "database error", i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage, append(tc.opts, tasks.WithClock(clock))...)
+			reminders, next, err := service.PendingReminders(context.Background())
+			tc.wantErr(t, err)
+			assert.Equal(t, tc.wantReminders, reminders)
+			assert.Equal(t, tc.wantNext, next)
+		})
+	}
+}
+
+func TestTaskService_MarkReminderSent(t *testing.T) {
+	t.Parallel()
+
+	reminder := models.Reminder{TaskID: 3, RemindAt: "2024-02-03T14:15:00Z"}
+
+	storage := mocks.NewTaskStorage(t)
+	passThroughTx(storage)
+	storage.On("CreateSentReminder", mock.Anything, reminder, "2024-02-03T14:20:00Z").Return(nil).Once()
+	storage.On("DeleteSentReminders", mock.Anything, "2024-02-03T13:20:00Z").Return(nil).Once()
+
+	service := tasks.New(storage, tasks.WithClock(fixedClock(time.Date(2024, 2, 3, 14, 20, 0, 0, time.UTC))))
+	require.NoError(t, service.MarkReminderSent(context.Background(), reminder))
+}
@@ -0,0 +1,93 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/nextdate"
+)
+
+// maxMissed limits how many missed occurrences of a task a single rollover records.
+// A task left behind for longer catches up over the following rollovers.
+const maxMissed = 1000
+
+// latestOffset is the offset of the easternmost time zone, where a day starts first.
+const latestOffset = 14 * time.Hour
+
+// Rollover moves the overdue recurring tasks to their next occurrence on or after today,
+// where today is evaluated in the time zone of the owner of each task, or in the default one if the owner has none.
+// Every missed occurrence is recorded in the task history as skipped.
+// Tasks anchored to completion are left alone as they are not due on fixed dates.
+// Each task is moved in its own transaction, so a task which fails does not hold back the others.
+// It returns the number of moved tasks and the errors encountered.
+func (service TaskService) Rollover(ctx context.Context) (int64, error) {
+	// No owner can be further into the day than the easternmost zone, so the tasks before its date are candidates
+	// and each is then checked against the date of its owner.
+	latest := service.clock.Now().UTC().Add(latestOffset).Format(lib.DateFormat)
+
+	overdue, err := service.storage.ReadOverdue(ctx, latest)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		moved int64
+		errs  []error
+	)
+	for _, task := range overdue {
+		today := service.todayIn(service.ownerLocation(task))
+		if task.Date >= today.Format(lib.DateFormat) {
+			continue
+		}
+
+		err := service.storage.InTx(ctx, func(ctx context.Context) error {
+			return service.rollover(ctx, task, today)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("task %d: %w", task.ID, err))
+			continue
+		}
+		moved++
+	}
+	return moved, errors.Join(errs...)
+}
+
+// rollover records the occurrences of a task missed before today as skipped and moves it to the next one.
+// The move is written to the audit log, with the system as its actor when ctx carries no user as for the rollover job.
+func (service TaskService) rollover(ctx context.Context, task models.Task, today time.Time) error {
+	rule, date, opts, err := service.schedule(task)
+	if err != nil {
+		return err
+	}
+	before := task
+
+	skippedAt := service.clock.Now().UTC().Format(time.RFC3339)
+	for missed := 1; ; missed++ {
+		_, err := service.storage.CreateCompletion(ctx, models.Completion{
+			TaskID:      task.ID,
+			Title:       task.Title,
+			Date:        task.Date,
+			CompletedAt: skippedAt,
+			Skipped:     true,
+		})
+		if err != nil {
+			return err
+		}
+
+		next := nextdate.Next(rule, date, date, opts...)
+		if next.IsZero() || !next.Before(today) || nextdate.Exhausted(rule, max(task.Occurrence, 1)) || missed == maxMissed {
+			if err := service.reschedule(ctx, task, rule, next); err != nil {
+				return err
+			}
+			return service.audit(ctx, models.OperationRollover, task.ID, &before)
+		}
+
+		date = next
+		task.Date = next.Format(lib.DateFormat)
+		task.Occurrence = max(task.Occurrence, 1) + 1
+	}
+}
@@ -0,0 +1,156 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskService_Rollover(t *testing.T) {
+	var (
+		clock     = fixedClock(time.Date(2024, 2, 3, 12, 0, 0, 0, time.UTC))
+		skippedAt = "2024-02-03T12:00:00Z"
+	)
+
+	kiritimati, err := time.LoadLocation("Pacific/Kiritimati")
+	require.NoError(t, err)
+
+	skipped := func(id int64, title, date string) models.Completion {
+		return models.Completion{TaskID: id, Title: title, Date: date, CompletedAt: skippedAt, Skipped: true}
+	}
+	// rolledOver expects the task to be read back after the move and the move to be written to the audit log.
+	// A nil after stands for a task which went to the trash.
+	rolledOver := func(m *mocks.TaskStorage, before models.Task, after *models.Task) {
+		if after == nil {
+			m.On("Read", mock.Anything, before.ID).Return(models.Task{}, errNotFound).Once()
+		} else {
+			m.On("Read", mock.Anything, before.ID).Return(*after, nil).Once()
+		}
+		m.
+			On("CreateAuditEntry", mock.Anything, models.AuditEntry{
+				Actor:     "system",
+				Operation: models.OperationRollover,
+				TaskID:    before.ID,
+				Before:    &before,
+				After:     after,
+				CreatedAt: skippedAt,
+			}).
+			Return(int64(1), nil).
+			Once()
+	}
+
+	tests := []struct {
+		name      string
+		opts      []tasks.Option
+		mockSetup func(m *mocks.TaskStorage)
+		wantMoved int64
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "missed occurrences are skipped up to today",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				task := models.Task{ID: 4, Date: "20240131", Title: "Water plants", Repeat: "d 1", Anchor: models.AnchorDue, Occurrence: 1, Status: models.StatusInProgress}
+				moved := models.Task{ID: 4, Date: "20240203", Title: "Water plants", Repeat: "d 1", Anchor: models.AnchorDue, Occurrence: 4, Status: models.StatusTodo}
+				m.On("ReadOverdue", mock.Anything, "20240204").Return([]models.Task{task}, nil)
+				m.On("CreateCompletion", mock.Anything, skipped(4, "Water plants", "20240131")).Return(int64(1), nil).Once()
+				m.On("CreateCompletion", mock.Anything, skipped(4, "Water plants", "20240201")).Return(int64(2), nil).Once()
+				m.On("CreateCompletion", mock.Anything, skipped(4, "Water plants", "20240202")).Return(int64(3), nil).Once()
+				m.On("Update", mock.Anything, &moved).Return(nil).Once()
+				m.On("ResetChildren", mock.Anything, int64(4), "20240203").Return(nil).Once()
+				rolledOver(m, task, &moved)
+			},
+			wantMoved: 1,
+			wantErr:   require.NoError,
+		},
+		{
+			name: "task is deleted when its rule runs out",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				task := models.Task{ID: 4, Date: "20240131", Title: "Water plants", Repeat: "d 1 count=2", Anchor: models.AnchorDue, Occurrence: 1}
+				m.On("ReadOverdue", mock.Anything, "20240204").Return([]models.Task{task}, nil)
+				m.On("CreateCompletion", mock.Anything, skipped(4, "Water plants", "20240131")).Return(int64(1), nil).Once()
+				m.On("CreateCompletion", mock.Anything, skipped(4, "Water plants", "20240201")).Return(int64(2), nil).Once()
+				m.On("Delete", mock.Anything, int64(4)).Return(nil).Once()
+				rolledOver(m, task, nil)
+			},
+			wantMoved: 1,
+			wantErr:   require.NoError,
+		},
+		{
+			name: "failed task does not hold back the others",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				laundry := models.Task{ID: 5, Date: "20240127", Title: "Laundry", Repeat: "w 6", Anchor: models.AnchorDue, Occurrence: 2}
+				moved := models.Task{ID: 5, Date: "20240203", Title: "Laundry", Repeat: "w 6", Anchor: models.AnchorDue, Occurrence: 3, Status: models.StatusTodo}
+				m.On("ReadOverdue", mock.Anything, "20240204").Return([]models.Task{
+					{ID: 4, Date: "20240202", Title: "Water plants", Repeat: "d 1", Anchor: models.AnchorDue, Occurrence: 1},
+					laundry,
+				}, nil)
+				m.On("CreateCompletion", mock.Anything, skipped(4, "Water plants", "20240202")).Return(int64(0), errors.New("database error")).Once()
+				m.On("CreateCompletion", mock.Anything, skipped(5, "Laundry", "20240127")).Return(int64(1), nil).Once()
+				m.On("Update", mock.Anything, &moved).Return(nil).Once()
+				m.On("ResetChildren", mock.Anything, int64(5), "20240203").Return(nil).Once()
+				rolledOver(m, laundry, &moved)
+			},
+			wantMoved: 1,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.EqualError(tt, err, "task 4: database error", i...)
+			},
+		},
+		{
+			name: "today is evaluated in the time zone of the owner",
+			opts: []tasks.Option{tasks.WithLocation(kiritimati)},
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				// It is already February 4 in the default zone, but still February 3 for the owner of task 4.
+				task := models.Task{ID: 5, Date: "20240203", Title: "Laundry", Repeat: "d 1", Anchor: models.AnchorDue, Occurrence: 1}
+				moved := models.Task{ID: 5, Date: "20240204", Title: "Laundry", Repeat: "d 1", Anchor: models.AnchorDue, Occurrence: 2, Status: models.StatusTodo}
+				m.On("ReadOverdue", mock.Anything, "20240204").Return([]models.Task{
+					{ID: 4, Date: "20240203", Title: "Water plants", Repeat: "d 1", Anchor: models.AnchorDue, Occurrence: 1, OwnerTimezone: "America/New_York"},
+					task,
+				}, nil)
+				m.On("CreateCompletion", mock.Anything, skipped(5, "Laundry", "20240203")).Return(int64(1), nil).Once()
+				m.On("Update", mock.Anything, &moved).Return(nil).Once()
+				m.On("ResetChildren", mock.Anything, int64(5), "20240204").Return(nil).Once()
+				rolledOver(m, task, &moved)
+			},
+			wantMoved: 1,
+			wantErr:   require.NoError,
+		},
+		{
+			name: "database error",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("ReadOverdue", mock.Anything, "20240204").Return(nil, errors.New("database error"))
+			},
+			wantMoved: 0,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.EqualError(tt, err, "database error", i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage, append(tc.opts, tasks.WithClock(clock))...)
+			moved, err := service.Rollover(context.Background())
+			tc.wantErr(t, err)
+			assert.Equal(t, tc.wantMoved, moved)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// ErrParentNotFound is returned when a subtask is added to a task which does not exist.
+var ErrParentNotFound = domain.New(domain.ErrNotFound, "parent task not found")
+
+// ErrNestedSubtask is returned when a subtask is added to another subtask. Tasks are nested one level deep.
+var ErrNestedSubtask = domain.New(domain.ErrInvalidRule, "subtasks cannot have subtasks")
+
+// ErrRecurringSubtask is returned when a subtask is given a repeat rule. Subtasks follow the schedule of their parent.
+var ErrRecurringSubtask = domain.New(domain.ErrInvalidRule, "subtasks cannot repeat")
+
+// checkParent makes sure that the task can be added as a subtask of its parent. Zero stands for a top-level task.
+func (service TaskService) checkParent(ctx context.Context, task models.Task) error {
+	if task.ParentID == 0 {
+		return nil
+	}
+	if task.Repeat != "" {
+		return ErrRecurringSubtask
+	}
+
+	parent, err := service.storage.Read(ctx, task.ParentID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return ErrParentNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if parent.ParentID != 0 {
+		return ErrNestedSubtask
+	}
+	return nil
+}
+
+// completeChild checks off a subtask. It stays with its parent in the done status
+// until the parent is completed, deleted or, for recurring parents, rolled forward.
+// The parent itself is never completed on behalf of its subtasks.
+func (service TaskService) completeChild(ctx context.Context, task models.Task) error {
+	task.Status = models.StatusDone
+	return service.write(ctx, &task)
+}
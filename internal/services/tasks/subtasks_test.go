@@ -0,0 +1,149 @@
+package tasks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTaskService_Register_Subtask(t *testing.T) {
+	tests := []struct {
+		name      string
+		task      models.Task
+		mockSetup func(m *mocks.TaskStorage)
+		wantErr   error
+	}{
+		{
+			name: "subtask is added",
+			task: models.Task{Date: "20250410", Title: "Kitchen", ParentID: 7},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(7)).Return(models.Task{ID: 7, Title: "Clean"}, nil)
+				m.
+					On("Create", mock.Anything, models.Task{Date: "20250410", Title: "Kitchen", Anchor: models.AnchorDue, Priority: models.PriorityNormal, Status: models.StatusTodo, ParentID: 7}).
+					Return(int64(8), nil)
+				m.On("Read", mock.Anything, int64(8)).Return(models.Task{ID: 8, Title: "Kitchen", ParentID: 7}, nil)
+				recordsOperation(m)
+			},
+		},
+		{
+			name: "parent does not exist",
+			task: models.Task{Date: "20250410", Title: "Kitchen", ParentID: 7},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(7)).Return(models.Task{}, errNotFound)
+			},
+			wantErr: tasks.ErrParentNotFound,
+		},
+		{
+			name: "parent is a subtask itself",
+			task: models.Task{Date: "20250410", Title: "Sink", ParentID: 8},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(8)).Return(models.Task{ID: 8, Title: "Kitchen", ParentID: 7}, nil)
+			},
+			wantErr: tasks.ErrNestedSubtask,
+		},
+		{
+			name:      "subtask with a repeat rule",
+			task:      models.Task{Date: "20250410", Title: "Kitchen", Repeat: "d 1", ParentID: 7},
+			mockSetup: func(m *mocks.TaskStorage) {},
+			wantErr:   tasks.ErrRecurringSubtask,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage)
+			_, err := service.Register(context.Background(), tc.task)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestTaskService_Update_Subtask(t *testing.T) {
+	storage := mocks.NewTaskStorage(t)
+	passThroughTx(storage)
+	storage.On("Read", mock.Anything, int64(8)).Return(models.Task{ID: 8, Title: "Kitchen", ParentID: 7}, nil)
+
+	service := tasks.New(storage)
+	err := service.Update(context.Background(), models.Task{ID: 8, Date: "20250410", Title: "Kitchen", Repeat: "d 1"})
+	assert.ErrorIs(t, err, tasks.ErrRecurringSubtask)
+}
+
+func TestTaskService_Complete_Subtasks(t *testing.T) {
+	clock := fixedClock(time.Date(2025, 4, 10, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name      string
+		id        int64
+		mockSetup func(m *mocks.TaskStorage)
+	}{
+		{
+			name: "subtask is checked off and kept",
+			id:   8,
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(8)).Return(models.Task{ID: 8, Date: "20250410", Title: "Kitchen", Status: models.StatusTodo, ParentID: 7}, nil)
+				m.On("CreateCompletion", mock.Anything, mock.Anything).Return(int64(1), nil)
+				recordsOperation(m)
+				m.On("Update", mock.Anything, &models.Task{ID: 8, Date: "20250410", Title: "Kitchen", Status: models.StatusDone, ParentID: 7}).Return(nil)
+			},
+		},
+		{
+			name: "recurring parent resets its subtasks",
+			id:   7,
+			mockSetup: func(m *mocks.TaskStorage) {
+				children := []models.Task{{ID: 8, Date: "20250410", Title: "Kitchen", Status: models.StatusDone, ParentID: 7}}
+				m.On("Read", mock.Anything, int64(7)).Return(models.Task{ID: 7, Date: "20250410", Title: "Clean", Repeat: "d 7", Occurrence: 1}, nil)
+				m.On("CreateCompletion", mock.Anything, mock.Anything).Return(int64(1), nil)
+				m.On("ReadChildren", mock.Anything, int64(7)).Return(children, nil)
+				m.
+					On("CreateOperation", mock.Anything, mock.MatchedBy(func(op models.Operation) bool {
+						return assert.ObjectsAreEqual(children, op.Task.Children)
+					})).
+					Return(int64(1), nil)
+				m.On("DeleteOperations", mock.Anything, mock.Anything).Return(nil)
+				m.On("CreateAuditEntry", mock.Anything, mock.Anything).Return(int64(1), nil)
+				m.On("Update", mock.Anything, mock.MatchedBy(func(task *models.Task) bool { return task.Date == "20250417" })).Return(nil)
+				m.On("ResetChildren", mock.Anything, int64(7), "20250417").Return(nil)
+			},
+		},
+		{
+			name: "one-off parent takes its subtasks to the trash",
+			id:   7,
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(7)).Return(models.Task{ID: 7, Date: "20250410", Title: "Clean"}, nil)
+				m.On("CreateCompletion", mock.Anything, mock.Anything).Return(int64(1), nil)
+				recordsOperation(m)
+				m.On("Delete", mock.Anything, int64(7)).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			withoutBlockers(storage)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage, tasks.WithClock(clock))
+			assert.NoError(t, service.Complete(context.Background(), tc.id, 0, false))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// ErrTagNotFound is returned when a tag to be renamed or merged does not exist.
+var ErrTagNotFound = domain.New(domain.ErrNotFound, "tag not found")
+
+// ErrTagExists is returned when a tag is renamed to the name of another tag. Such tags are merged instead.
+var ErrTagExists = domain.New(domain.ErrConflict, "tag already exists")
+
+// normalizeTags trims and lowercases tag names, drops empty ones and duplicates and sorts the rest.
+func normalizeTags(names []string) []string {
+	if names == nil {
+		return nil
+	}
+
+	tags := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			tags = append(tags, name)
+		}
+	}
+	slices.Sort(tags)
+	return slices.Compact(tags)
+}
+
+// Tags retrieves all tags in alphabetical order with the number of tasks carrying them.
+func (service TaskService) Tags(ctx context.Context) ([]models.Tag, error) {
+	return service.storage.ReadTags(ctx)
+}
+
+// RenameTag gives a tag a new name on all its tasks.
+// It returns ErrTagNotFound if there is no tag with the name
+// and ErrTagExists if the new name is taken by another tag.
+func (service TaskService) RenameTag(ctx context.Context, name, newName string) error {
+	name, newName = strings.ToLower(strings.TrimSpace(name)), strings.ToLower(strings.TrimSpace(newName))
+
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		id, err := service.storage.TagID(ctx, name)
+		if err != nil {
+			return err
+		}
+		if id == 0 {
+			return fmt.Errorf("%w: %q", ErrTagNotFound, name)
+		}
+		if name == newName {
+			return nil
+		}
+
+		taken, err := service.storage.TagID(ctx, newName)
+		if err != nil {
+			return err
+		}
+		if taken != 0 {
+			return fmt.Errorf("%w: %q", ErrTagExists, newName)
+		}
+
+		return service.storage.RenameTag(ctx, id, newName)
+	})
+}
+
+// MergeTags moves the tasks of the given tags to the into tag and removes the merged tags.
+// The into tag is created if it does not exist. It returns ErrTagNotFound
+// if any of the merged tags does not exist, in which case nothing is changed.
+func (service TaskService) MergeTags(ctx context.Context, names []string, into string) error {
+	into = strings.ToLower(strings.TrimSpace(into))
+
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		intoID, err := service.storage.TagID(ctx, into)
+		if err != nil {
+			return err
+		}
+		if intoID == 0 {
+			if intoID, err = service.storage.CreateTag(ctx, into); err != nil {
+				return err
+			}
+		}
+
+		for _, name := range normalizeTags(names) {
+			if name == into {
+				continue
+			}
+
+			id, err := service.storage.TagID(ctx, name)
+			if err != nil {
+				return err
+			}
+			if id == 0 {
+				return fmt.Errorf("%w: %q", ErrTagNotFound, name)
+			}
+
+			if err := service.storage.MergeTag(ctx, id, intoID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,148 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskService_RenameTag(t *testing.T) {
+	tests := []struct {
+		name      string
+		newName   string
+		mockSetup func(m *mocks.TaskStorage)
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name:    "tag is renamed",
+			newName: " House",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("TagID", mock.Anything, "home").Return(int64(2), nil)
+				m.On("TagID", mock.Anything, "house").Return(int64(0), nil)
+				m.On("RenameTag", mock.Anything, int64(2), "house").Return(nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "same name is left alone",
+			newName: "Home",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("TagID", mock.Anything, "home").Return(int64(2), nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "tag does not exist",
+			newName: "house",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("TagID", mock.Anything, "home").Return(int64(0), nil)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, tasks.ErrTagNotFound, i...)
+			},
+		},
+		{
+			name:    "new name is taken",
+			newName: "work",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("TagID", mock.Anything, "home").Return(int64(2), nil)
+				m.On("TagID", mock.Anything, "work").Return(int64(3), nil)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, tasks.ErrTagExists, i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage)
+			tc.wantErr(t, service.RenameTag(context.Background(), "home", tc.newName))
+		})
+	}
+}
+
+func TestTaskService_MergeTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		names     []string
+		mockSetup func(m *mocks.TaskStorage)
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name:  "tags are merged into an existing tag",
+			names: []string{"house", "Errands", "chores"},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("TagID", mock.Anything, "chores").Return(int64(1), nil)
+				m.On("TagID", mock.Anything, "errands").Return(int64(4), nil)
+				m.On("TagID", mock.Anything, "house").Return(int64(5), nil)
+				m.On("MergeTag", mock.Anything, int64(4), int64(1)).Return(nil)
+				m.On("MergeTag", mock.Anything, int64(5), int64(1)).Return(nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:  "missing target tag is created",
+			names: []string{"house"},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("TagID", mock.Anything, "chores").Return(int64(0), nil)
+				m.On("CreateTag", mock.Anything, "chores").Return(int64(6), nil)
+				m.On("TagID", mock.Anything, "house").Return(int64(5), nil)
+				m.On("MergeTag", mock.Anything, int64(5), int64(6)).Return(nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:  "merged tag does not exist",
+			names: []string{"house"},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("TagID", mock.Anything, "chores").Return(int64(1), nil)
+				m.On("TagID", mock.Anything, "house").Return(int64(0), nil)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, tasks.ErrTagNotFound, i...)
+			},
+		},
+		{
+			name:  "database error",
+			names: []string{"house"},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("TagID", mock.Anything, "chores").Return(int64(1), nil)
+				m.On("TagID", mock.Anything, "house").Return(int64(5), nil)
+				m.On("MergeTag", mock.Anything, int64(5), int64(1)).Return(errors.New("database error"))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.EqualError(tt, err, "database error", i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage)
+			tc.wantErr(t, service.MergeTags(context.Background(), tc.names, "Chores"))
+		})
+	}
+}
@@ -2,124 +2,717 @@ package tasks
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/10Narratives/task-tracker/internal/lib"
+	"github.com/10Narratives/task-tracker/internal/lib/timezone"
 	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
 	"github.com/10Narratives/task-tracker/internal/services/nextdate"
 )
 
+// ErrNotRecurring is returned when an operation on recurring tasks is applied to a one-off task.
+var ErrNotRecurring = domain.New(domain.ErrInvalidRule, "task is not recurring")
+
+// ErrNotInTrash is returned when a task to be restored is not in the trash.
+var ErrNotInTrash = domain.New(domain.ErrNotFound, "task is not in the trash")
+
+// ErrTaskNotFound is returned when there is no task with the given ID.
+var ErrTaskNotFound = domain.New(domain.ErrNotFound, "task not found")
+
+// ErrVersionMismatch is returned when a task is changed based on a version other than its current one.
+var ErrVersionMismatch = domain.New(domain.ErrPrecondition, "task was changed in the meantime")
+
 // TaskStorage is an interface for working with task storage.
 // It defines methods for creating, reading, updating, and deleting tasks.
+// Calls whose context carries a user, see identity.WithUser, only see and change the records of that user,
+// while the calls of the background workers, which carry none, see the records of all users.
 //
 //go:generate go run github.com/vektra/mockery/v2@v2.28.2 --name=TaskStorage
 type TaskStorage interface {
 
 	// Create adds a new task to the storage and returns its ID and any error encountered.
-	Create(ctx context.Context, date, title, comment, repeat string) (int64, error)
+	Create(ctx context.Context, task models.Task) (int64, error)
 
 	// Read retrieves a task by its ID.
-	// It returns the task and any error encountered.
+	// It returns the task and any error encountered, which is of the domain.ErrNotFound kind if there is no such task.
 	Read(ctx context.Context, id int64) (models.Task, error)
 
-	// ReadGroup retrieves all tasks from the storage.
+	// ReadGroup retrieves all tasks matching the filter from the storage.
 	// It returns a slice of tasks and any error encountered.
-	ReadGroup(ctx context.Context) ([]models.Task, error)
+	ReadGroup(ctx context.Context, filter models.TaskFilter) ([]models.Task, error)
 
-	// ReadByDate retrieves tasks associated with a specific date.
+	// ReadByDate retrieves tasks associated with a specific date and matching the filter.
 	// It returns a slice of tasks and any error encountered.
-	ReadByDate(ctx context.Context, date string) ([]models.Task, error)
+	ReadByDate(ctx context.Context, date string, filter models.TaskFilter) ([]models.Task, error)
 
-	// ReadByPayload retrieves tasks that match a specific search payload.
+	// ReadByPayload retrieves tasks that match a specific search payload and the filter.
 	// It returns a slice of tasks and any error encountered.
-	ReadByPayload(ctx context.Context, payload string) ([]models.Task, error)
+	ReadByPayload(ctx context.Context, payload string, filter models.TaskFilter) ([]models.Task, error)
 
-	// Update modifies an existing task in the storage.
-	// It returns any error encountered during the update.
+	// Update modifies an existing task in the storage and bumps its version.
+	// It returns any error encountered during the update, which is of the domain.ErrNotFound kind if there is no such task.
 	Update(ctx context.Context, t *models.Task) error
 
-	// Delete removes a task from the storage by its ID.
-	// It returns any error encountered during deletion.
+	// Delete moves a task to the trash by its ID together with its subtasks.
+	// It returns any error encountered during deletion, which is of the domain.ErrNotFound kind if there is no such task.
 	Delete(ctx context.Context, id int64) error
+
+	// ReadTrash retrieves the trashed tasks, most recently deleted first.
+	// It returns a slice of tasks and any error encountered.
+	ReadTrash(ctx context.Context) ([]models.Task, error)
+
+	// Restore takes a task out of the trash together with the subtasks trashed along with it.
+	// It returns any error encountered, which is of the domain.ErrNotFound kind if there is no trashed task with the ID.
+	Restore(ctx context.Context, id int64) error
+
+	// Purge permanently removes the tasks trashed before the given RFC 3339 time.
+	// It returns the number of removed tasks and any error encountered.
+	Purge(ctx context.Context, before string) (int64, error)
+
+	// CreateCompletion records a completed task occurrence and returns its ID and any error encountered.
+	CreateCompletion(ctx context.Context, completion models.Completion) (int64, error)
+
+	// ReadCompletions retrieves the completions matching the filter ordered by completion time.
+	// It returns a slice of completions and any error encountered.
+	ReadCompletions(ctx context.Context, filter models.CompletionFilter) ([]models.Completion, error)
+
+	// DeleteCompletion removes a recorded completion by its ID.
+	// It returns any error encountered during deletion.
+	DeleteCompletion(ctx context.Context, id int64) error
+
+	// CreateOperation records a reversible operation and returns its ID and any error encountered.
+	CreateOperation(ctx context.Context, op models.Operation) (int64, error)
+
+	// LastOperation retrieves the most recent operation made at or after the given RFC 3339 time.
+	// It returns an empty operation if there is none.
+	LastOperation(ctx context.Context, since string) (models.Operation, error)
+
+	// DeleteOperation removes a recorded operation by its ID.
+	// It returns any error encountered during deletion.
+	DeleteOperation(ctx context.Context, id int64) error
+
+	// DeleteOperations removes the operations made before the given RFC 3339 time.
+	// It returns any error encountered during deletion.
+	DeleteOperations(ctx context.Context, before string) error
+
+	// SetTags replaces the tags of a task, creating the tags which do not exist yet.
+	// It returns any error encountered.
+	SetTags(ctx context.Context, taskID int64, names []string) error
+
+	// ReadTags retrieves all tags in alphabetical order with the number of tasks carrying them.
+	// It returns a slice of tags and any error encountered.
+	ReadTags(ctx context.Context) ([]models.Tag, error)
+
+	// TagID looks a tag up by its name. It returns 0 if there is no tag with the name.
+	TagID(ctx context.Context, name string) (int64, error)
+
+	// CreateTag adds a tag which is not attached to any task yet and returns its ID and any error encountered.
+	CreateTag(ctx context.Context, name string) (int64, error)
+
+	// RenameTag changes the name of a tag.
+	// It returns any error encountered during the update.
+	RenameTag(ctx context.Context, id int64, name string) error
+
+	// MergeTag moves the tasks of the from tag to the into tag and removes the from tag.
+	// It returns any error encountered.
+	MergeTag(ctx context.Context, from, into int64) error
+
+	// CreateProject adds a new project and returns its ID and any error encountered.
+	CreateProject(ctx context.Context, p models.Project) (int64, error)
+
+	// ReadProject retrieves a project by its ID.
+	// It returns the project and any error encountered, which is of the domain.ErrNotFound kind if there is no such project.
+	ReadProject(ctx context.Context, id int64) (models.Project, error)
+
+	// ReadProjects retrieves all projects, active ones first, in alphabetical order.
+	// It returns a slice of projects and any error encountered.
+	ReadProjects(ctx context.Context) ([]models.Project, error)
+
+	// UpdateProject changes the name, colour and archive state of a project.
+	// It returns any error encountered, which is of the domain.ErrNotFound kind if there is no such project.
+	UpdateProject(ctx context.Context, p models.Project) error
+
+	// DeleteProject removes a project, keeping its tasks without a project.
+	// It returns any error encountered, which is of the domain.ErrNotFound kind if there is no such project.
+	DeleteProject(ctx context.Context, id int64) error
+
+	// ReadChildren retrieves the subtasks of a task in the order they were added.
+	// It returns a slice of subtasks and any error encountered.
+	ReadChildren(ctx context.Context, parentID int64) ([]models.Task, error)
+
+	// ResetChildren moves the subtasks of a task to the given date and back to the todo status.
+	// It returns any error encountered during the update.
+	ResetChildren(ctx context.Context, parentID int64, date string) error
+
+	// AddDependency makes a task wait for its blocker.
+	// It returns any error encountered.
+	AddDependency(ctx context.Context, taskID, blockerID int64) error
+
+	// RemoveDependency stops a task from waiting for its blocker.
+	// It returns any error encountered.
+	RemoveDependency(ctx context.Context, taskID, blockerID int64) error
+
+	// DependsOn reports whether a task waits for the blocker, directly or through other tasks.
+	DependsOn(ctx context.Context, taskID, blockerID int64) (bool, error)
+
+	// OpenBlockers retrieves the IDs of the blockers of a task which are outside the trash and not done yet,
+	// leaving out the recurring blockers completed since the dependency was added.
+	// It returns a slice of IDs and any error encountered.
+	OpenBlockers(ctx context.Context, taskID int64) ([]int64, error)
+
+	// ReadRemindedTasks retrieves the tasks outside the trash which have reminders and are not done yet,
+	// each with the time zone of its owner. It returns a slice of tasks and any error encountered.
+	ReadRemindedTasks(ctx context.Context) ([]models.Task, error)
+
+	// ReadSentReminders retrieves the reminders due at or after the given RFC 3339 time which have already been sent.
+	// It returns a slice of reminders and any error encountered.
+	ReadSentReminders(ctx context.Context, since string) ([]models.Reminder, error)
+
+	// CreateSentReminder records that a reminder has been sent at the given RFC 3339 time.
+	// It returns any error encountered.
+	CreateSentReminder(ctx context.Context, r models.Reminder, sentAt string) error
+
+	// DeleteSentReminders removes the records of the reminders due before the given RFC 3339 time.
+	// It returns any error encountered during deletion.
+	DeleteSentReminders(ctx context.Context, before string) error
+
+	// ReadOverdue retrieves the recurring top-level tasks anchored to their due date which are scheduled before the given date,
+	// each with the time zone of its owner. It returns a slice of tasks and any error encountered.
+	ReadOverdue(ctx context.Context, before string) ([]models.Task, error)
+
+	// CreateAuditEntry appends a change of a task to the audit log and returns its ID and any error encountered.
+	CreateAuditEntry(ctx context.Context, entry models.AuditEntry) (int64, error)
+
+	// ReadAuditEntries retrieves a page of the audit entries matching the filter, most recent first.
+	// It returns a slice of entries and any error encountered.
+	ReadAuditEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error)
+
+	// InTx runs fn in a transaction. Storage calls made with the context passed to fn take part in it.
+	// The transaction is committed if fn returns nil and rolled back otherwise.
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Clock provides the current time.
+// It is replaced in tests to make scheduling deterministic.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
 }
 
 // TaskService manages tasks within the application.
 type TaskService struct {
 	// TaskStorage is the instance of task storage.
 	storage TaskStorage
+	// clock is the source of the current time.
+	clock Clock
+	// location is the default time zone used to decide which day is today.
+	location *time.Location
+	// calendar lists the holidays skipped by working day rules.
+	calendar nextdate.Calendar
+	// undoWindow is how long an operation can be undone for.
+	undoWindow time.Duration
+	// reminderGrace is how late a reminder can still be sent.
+	reminderGrace time.Duration
+}
+
+// Option configures a TaskService.
+type Option func(*TaskService)
+
+// WithClock sets the source of the current time. The system clock is used by default.
+func WithClock(clock Clock) Option {
+	return func(service *TaskService) {
+		service.clock = clock
+	}
+}
+
+// WithLocation sets the default time zone in which "today" is evaluated.
+// It is used for requests which do not carry their own zone. UTC is used by default.
+func WithLocation(loc *time.Location) Option {
+	return func(service *TaskService) {
+		service.location = loc
+	}
+}
+
+// WithCalendar sets the holiday calendar used by working day repeat rules.
+// Without a calendar only weekends are treated as days off.
+func WithCalendar(cal nextdate.Calendar) Option {
+	return func(service *TaskService) {
+		service.calendar = cal
+	}
+}
+
+// WithUndoWindow sets how long an operation can be undone for. Ten minutes are used by default.
+func WithUndoWindow(window time.Duration) Option {
+	return func(service *TaskService) {
+		service.undoWindow = window
+	}
 }
 
 // New creates a new TaskService with the given TaskStorage.
-func New(storage TaskStorage) TaskService {
-	return TaskService{storage: storage}
+func New(storage TaskStorage, opts ...Option) TaskService {
+	service := TaskService{storage: storage, clock: systemClock{}, location: time.UTC, undoWindow: defaultUndoWindow, reminderGrace: defaultReminderGrace}
+	for _, opt := range opts {
+		opt(&service)
+	}
+	return service
+}
+
+// userLocation returns the time zone carried by ctx or the default one.
+func (service TaskService) userLocation(ctx context.Context) *time.Location {
+	if userLoc, ok := timezone.FromContext(ctx); ok {
+		return userLoc
+	}
+	return service.location
+}
+
+// today returns the current date in the user's time zone as midnight UTC,
+// which is how task dates are represented once parsed.
+func (service TaskService) today(ctx context.Context) time.Time {
+	return service.todayIn(service.userLocation(ctx))
+}
+
+// todayIn returns the current date in the time zone as midnight UTC.
+func (service TaskService) todayIn(loc *time.Location) time.Time {
+	year, month, day := service.clock.Now().In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
 }
 
 // Register creates a new task with the specified details.
+// Tasks without an anchor mode are anchored to their due date,
+// tasks without a priority or status get the normal priority and the todo status.
+// Tags which do not exist yet are created. The registration can be undone.
+// It returns ErrProjectNotFound or ErrProjectArchived if the task cannot be added to its project
+// and ErrParentNotFound, ErrNestedSubtask or ErrRecurringSubtask if it cannot be added as a subtask.
 // It returns the ID of the created task and any error encountered.
-func (service TaskService) Register(ctx context.Context, date, title, comment, repeat string) (int64, error) {
-	return service.storage.Create(ctx, date, title, comment, repeat)
+func (service TaskService) Register(ctx context.Context, task models.Task) (int64, error) {
+	if task.Anchor == "" {
+		task.Anchor = models.AnchorDue
+	}
+	if task.Priority == 0 {
+		task.Priority = models.PriorityNormal
+	}
+	if task.Status == "" {
+		task.Status = models.StatusTodo
+	}
+	task.Tags = normalizeTags(task.Tags)
+
+	var id int64
+	err := service.storage.InTx(ctx, func(ctx context.Context) error {
+		if err := service.checkProject(ctx, task.ProjectID); err != nil {
+			return err
+		}
+		if err := service.checkParent(ctx, task); err != nil {
+			return err
+		}
+
+		var err error
+		id, err = service.storage.Create(ctx, task)
+		if err != nil {
+			return err
+		}
+		if len(task.Tags) > 0 {
+			if err := service.storage.SetTags(ctx, id, task.Tags); err != nil {
+				return err
+			}
+		}
+		if err := service.record(ctx, models.Operation{Kind: models.OperationRegister, TaskID: id}); err != nil {
+			return err
+		}
+		return service.audit(ctx, models.OperationRegister, id, nil)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
 }
 
-// Task retrieves a task by its ID.
-// It returns the task and any error encountered.
+// read retrieves a task by its ID. It returns ErrTaskNotFound if there is no task with the ID.
+func (service TaskService) read(ctx context.Context, id int64) (models.Task, error) {
+	task, err := service.storage.Read(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.Task{}, fmt.Errorf("%w: %d", ErrTaskNotFound, id)
+	}
+	return task, err
+}
+
+// write stores the changed details of a task read before in the same transaction.
+// It returns ErrVersionMismatch if a concurrent writer has changed the task since.
+func (service TaskService) write(ctx context.Context, task *models.Task) error {
+	err := service.storage.Update(ctx, task)
+	if errors.Is(err, domain.ErrPrecondition) {
+		return fmt.Errorf("%w: %d", ErrVersionMismatch, task.ID)
+	}
+	return err
+}
+
+// checkVersion makes sure that a change based on the given version of the task does not overwrite a newer one.
+// Zero stands for any version.
+func checkVersion(task models.Task, version int64) error {
+	if version != 0 && version != task.Version {
+		return fmt.Errorf("%w: version %d is not the current version %d", ErrVersionMismatch, version, task.Version)
+	}
+	return nil
+}
+
+// Task retrieves a task by its ID together with its subtasks.
+// It returns ErrTaskNotFound if there is no task with the ID.
 func (service TaskService) Task(ctx context.Context, id int64) (models.Task, error) {
-	return service.storage.Read(ctx, id)
+	task, err := service.read(ctx, id)
+	if err != nil || task.ParentID != 0 {
+		return task, err
+	}
+
+	children, err := service.storage.ReadChildren(ctx, id)
+	if err != nil {
+		return models.Task{}, err
+	}
+	if len(children) > 0 {
+		task.Children = children
+	}
+	return task, nil
 }
 
-// Tasks retrieves a list of tasks based on the search criteria.
+// Tasks retrieves a list of tasks based on the search criteria and the filter.
 // If search is empty, it returns all tasks. If search is a date, it returns tasks for that date.
 // Otherwise, it searches for tasks matching the payload.
-func (service TaskService) Tasks(ctx context.Context, search string) ([]models.Task, error) {
+// The filter narrows the result down by priority, status, project and tags and sets its order.
+// Subtasks are left out, they are read together with their parent by Task.
+func (service TaskService) Tasks(ctx context.Context, search string, filter models.TaskFilter) ([]models.Task, error) {
+	filter.Tags = normalizeTags(filter.Tags)
+
 	var (
 		tasks []models.Task
 		err   error
 	)
 	if search == "" {
-		tasks, err = service.storage.ReadGroup(ctx)
+		tasks, err = service.storage.ReadGroup(ctx, filter)
 	} else if date, isDate := time.Parse(lib.SearchDateFormat, search); isDate == nil {
-		tasks, err = service.storage.ReadByDate(ctx, date.Format(lib.DateFormat))
+		tasks, err = service.storage.ReadByDate(ctx, date.Format(lib.DateFormat), filter)
 	} else {
-		tasks, err = service.storage.ReadByPayload(ctx, search)
+		tasks, err = service.storage.ReadByPayload(ctx, search, filter)
 	}
 	return tasks, err
 }
 
-// Delete removes a task by its ID.
-// It returns any error encountered during deletion.
-func (service TaskService) Delete(ctx context.Context, id int64) error {
-	return service.storage.Delete(ctx, id)
+// Delete moves a task to the trash by its ID together with its subtasks. The deletion can be undone.
+// It returns ErrTaskNotFound if there is no task with the ID and ErrVersionMismatch
+// if version is not zero and the task has changed since that version.
+func (service TaskService) Delete(ctx context.Context, id, version int64) error {
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		task, err := service.read(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(task, version); err != nil {
+			return err
+		}
+
+		if err := service.storage.Delete(ctx, id); err != nil {
+			return err
+		}
+		if err := service.record(ctx, models.Operation{Kind: models.OperationDelete, TaskID: id, Task: &task}); err != nil {
+			return err
+		}
+		return service.audit(ctx, models.OperationDelete, id, &task)
+	})
+}
+
+// Trash retrieves the tasks in the trash, most recently deleted first.
+func (service TaskService) Trash(ctx context.Context) ([]models.Task, error) {
+	return service.storage.ReadTrash(ctx)
+}
+
+// Restore takes a task out of the trash together with the subtasks which went to the trash with it.
+// It returns ErrNotInTrash if there is no trashed task with the ID. All changes are made in a single transaction.
+func (service TaskService) Restore(ctx context.Context, id int64) error {
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		err := service.storage.Restore(ctx, id)
+		if errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("%w: %d", ErrNotInTrash, id)
+		}
+		if err != nil {
+			return err
+		}
+		return service.audit(ctx, models.OperationRestore, id, nil)
+	})
+}
+
+// Purge permanently removes the tasks which have been in the trash for longer than retention.
+// It returns the number of removed tasks and any error encountered.
+func (service TaskService) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	before := service.clock.Now().UTC().Add(-retention).Format(time.RFC3339)
+	return service.storage.Purge(ctx, before)
 }
 
 // Update modifies an existing task with the given details.
-// It returns any error encountered during the update.
-func (service TaskService) Update(ctx context.Context, id int64, date, title, comment, repeat string) error {
-	task := models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat}
-	return service.storage.Update(ctx, &task)
+// The occurrence counter of a recurring task is kept unless its repeat rule changes,
+// the anchor, priority, status, project, time of day, exception dates, reminders and tags are kept unless new ones are given.
+// An empty, non-nil list of exception dates, reminders or tags clears it, and ClearTime drops the time of day.
+// It returns ErrProjectNotFound or ErrProjectArchived if the task cannot be moved to the new project.
+// A subtask stays with its parent and returns ErrRecurringSubtask if it is given a repeat rule.
+// The previous state of the task is recorded so that the update can be undone.
+// It returns ErrTaskNotFound if there is no task with the ID and ErrVersionMismatch
+// if the version of the task is set and the stored task has changed since that version.
+func (service TaskService) Update(ctx context.Context, task models.Task) error {
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		current, err := service.read(ctx, task.ID)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(current, task.Version); err != nil {
+			return err
+		}
+
+		if task.Anchor == "" {
+			task.Anchor = current.Anchor
+		}
+		if task.Anchor == "" {
+			task.Anchor = models.AnchorDue
+		}
+		task.Occurrence = 1
+		if current.Repeat == task.Repeat && current.Occurrence > 0 {
+			task.Occurrence = current.Occurrence
+		}
+		if task.Priority == 0 {
+			task.Priority = current.Priority
+		}
+		if task.Status == "" {
+			task.Status = current.Status
+		}
+		if task.Time == "" && !task.ClearTime {
+			task.Time = current.Time
+		}
+		if task.ExDates == nil {
+			task.ExDates = current.ExDates
+		}
+		if task.Reminders == nil {
+			task.Reminders = current.Reminders
+		}
+		if task.ProjectID == 0 {
+			task.ProjectID = current.ProjectID
+		} else if task.ProjectID != current.ProjectID {
+			if err := service.checkProject(ctx, task.ProjectID); err != nil {
+				return err
+			}
+		}
+		task.ParentID = current.ParentID
+		if task.ParentID != 0 && task.Repeat != "" {
+			return ErrRecurringSubtask
+		}
+		task.Version = current.Version
+		if err := service.write(ctx, &task); err != nil {
+			return err
+		}
+		if task.Tags != nil {
+			if err := service.storage.SetTags(ctx, task.ID, normalizeTags(task.Tags)); err != nil {
+				return err
+			}
+		}
+		if err := service.record(ctx, models.Operation{Kind: models.OperationUpdate, TaskID: task.ID, Task: &current}); err != nil {
+			return err
+		}
+		return service.audit(ctx, models.OperationUpdate, task.ID, &current)
+	})
 }
 
-// Complete marks a task as complete.
-// If the task is not recurring, it will be deleted.
-// For recurring tasks, it updates the task date for the next occurrence.
-func (service TaskService) Complete(ctx context.Context, id int64) error {
-	task, err := service.storage.Read(ctx, id)
+// Complete marks a task as complete and records the completion in the task history.
+// If the task is not recurring or its repeat rule has ended, it will be deleted.
+// For recurring tasks, it updates the task date for the next occurrence after today,
+// where today is evaluated in the time zone carried by ctx or in the default one.
+// Tasks anchored to completion count the next occurrence from today instead of their scheduled date.
+// Subtasks go with their parent: they are moved to the trash with a one-off or ended parent,
+// come back when it is restored and start over in the todo status when a recurring parent moves on to its next occurrence.
+// A completed subtask is kept with its parent in the done status instead.
+// Unless force is set, it returns ErrBlocked if any of the task's blockers are still open.
+// It returns ErrTaskNotFound if there is no task with the ID and ErrVersionMismatch
+// if version is not zero and the task has changed since that version.
+// The completion can be undone. All changes are made in a single transaction.
+func (service TaskService) Complete(ctx context.Context, id, version int64, force bool) error {
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		task, err := service.read(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(task, version); err != nil {
+			return err
+		}
+		before := task
+
+		if !force {
+			if err := service.checkBlockers(ctx, task.ID); err != nil {
+				return err
+			}
+		}
+
+		completionID, err := service.storage.CreateCompletion(ctx, models.Completion{
+			TaskID:      task.ID,
+			Title:       task.Title,
+			Date:        task.Date,
+			CompletedAt: service.clock.Now().UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+
+		if task.ParentID == 0 && len(task.Repeat) > 0 {
+			// The subtasks are reset below, so their state is kept for undo.
+			children, err := service.storage.ReadChildren(ctx, task.ID)
+			if err != nil {
+				return err
+			}
+			if len(children) > 0 {
+				task.Children = children
+			}
+		}
+
+		err = service.record(ctx, models.Operation{Kind: models.OperationComplete, TaskID: task.ID, Task: &task, CompletionID: completionID})
+		if err != nil {
+			return err
+		}
+
+		if err := service.advance(ctx, task); err != nil {
+			return err
+		}
+		return service.audit(ctx, models.OperationComplete, task.ID, &before)
+	})
+}
+
+// advance moves a completed task on: a subtask stays with its parent in the done status,
+// a one-off task or one whose repeat rule has ended goes to the trash with its subtasks and a recurring task
+// to its next occurrence after today.
+func (service TaskService) advance(ctx context.Context, task models.Task) error {
+	if task.ParentID != 0 {
+		return service.completeChild(ctx, task)
+	}
+	if len(task.Repeat) == 0 {
+		return service.storage.Delete(ctx, task.ID)
+	}
+
+	rule, date, opts, err := service.schedule(task)
 	if err != nil {
 		return err
 	}
 
-	if len(task.Repeat) == 0 {
-		err = service.Delete(ctx, id)
+	today := service.today(ctx)
+	if task.Anchor == models.AnchorCompletion {
+		date = today
+	}
+
+	return service.reschedule(ctx, task, rule, nextdate.Next(rule, today, date, opts...))
+}
+
+// History returns the completions of a task, oldest first.
+// It returns ErrTaskNotFound if there is no task with the ID outside the trash,
+// so that a missing task is told apart from one which has never been completed.
+func (service TaskService) History(ctx context.Context, id int64) ([]models.Completion, error) {
+	if _, err := service.read(ctx, id); err != nil {
+		return nil, err
+	}
+	return service.storage.ReadCompletions(ctx, models.CompletionFilter{TaskID: id})
+}
+
+// Completions returns the completions of all tasks made between the from and to dates inclusive, oldest first.
+// Dates are in YYYYMMDD format and evaluated in the time zone carried by ctx or in the default one.
+// An empty date leaves the range open on its side.
+func (service TaskService) Completions(ctx context.Context, from, to string) ([]models.Completion, error) {
+	loc := service.userLocation(ctx)
+
+	var filter models.CompletionFilter
+	if from != "" {
+		start, err := time.ParseInLocation(lib.DateFormat, from, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date %q: %w", from, err)
+		}
+		filter.From = start.UTC().Format(time.RFC3339)
+	}
+	if to != "" {
+		end, err := time.ParseInLocation(lib.DateFormat, to, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date %q: %w", to, err)
+		}
+		filter.To = end.AddDate(0, 0, 1).UTC().Format(time.RFC3339)
+	}
+
+	return service.storage.ReadCompletions(ctx, filter)
+}
+
+// Skip moves a recurring task to the occurrence following its current date without completing it.
+// It returns ErrTaskNotFound if there is no task with the ID and ErrNotRecurring for tasks without a repeat rule.
+// All changes are made in a single transaction.
+func (service TaskService) Skip(ctx context.Context, id int64) error {
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		task, err := service.read(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if len(task.Repeat) == 0 {
+			return ErrNotRecurring
+		}
+
+		rule, date, opts, err := service.schedule(task)
 		if err != nil {
 			return err
 		}
-		return nil
+
+		if err := service.reschedule(ctx, task, rule, nextdate.Next(rule, date, date, opts...)); err != nil {
+			return err
+		}
+		return service.audit(ctx, models.OperationSkip, task.ID, &task)
+	})
+}
+
+// schedule parses the repeat rule and the date of a recurring task
+// and collects the options its next occurrence is calculated with.
+// Errors for a rule or dates which cannot be parsed are of the domain.ErrInvalidRule kind.
+func (service TaskService) schedule(task models.Task) (nextdate.Rule, time.Time, []nextdate.Option, error) {
+	rule, err := nextdate.Parse(task.Repeat)
+	if err != nil {
+		return nil, time.Time{}, nil, domain.Wrap(domain.ErrInvalidRule, err)
 	}
 
-	parsed, _ := time.Parse(lib.DateFormat, task.Date)
-	task.Date = nextdate.NextDate(time.Now(), parsed, task.Repeat)
-	err = service.storage.Update(ctx, &task)
+	date, err := time.Parse(lib.DateFormat, task.Date)
+	if err != nil {
+		return nil, time.Time{}, nil, domain.Wrap(domain.ErrInvalidRule, fmt.Errorf("task has invalid date %q: %w", task.Date, err))
+	}
+
+	excluded := make([]time.Time, 0, len(task.ExDates))
+	for _, exDate := range task.ExDates {
+		parsed, err := time.Parse(lib.DateFormat, exDate)
+		if err != nil {
+			return nil, time.Time{}, nil, domain.Wrap(domain.ErrInvalidRule, fmt.Errorf("task has invalid exception date %q: %w", exDate, err))
+		}
+		excluded = append(excluded, parsed)
+	}
+
+	opts := []nextdate.Option{nextdate.WithCalendar(service.calendar), nextdate.WithExcluded(excluded...)}
+	return rule, date, opts, nil
+}
+
+// reschedule moves a recurring task and its subtasks to its next occurrence, which starts in the todo status,
+// or moves it to the trash with its subtasks if the repeat rule has no occurrences left.
+func (service TaskService) reschedule(ctx context.Context, task models.Task, rule nextdate.Rule, next time.Time) error {
+	occurrence := max(task.Occurrence, 1)
+	if next.IsZero() || nextdate.Exhausted(rule, occurrence) {
+		return service.storage.Delete(ctx, task.ID)
+	}
+
+	task.Date = next.Format(lib.DateFormat)
+	task.Occurrence = occurrence + 1
+	task.Status = models.StatusTodo
+
+	err := service.write(ctx, &task)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return service.storage.ResetChildren(ctx, task.ID, task.Date)
 }
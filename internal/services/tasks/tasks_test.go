@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/10Narratives/task-tracker/internal/lib/timezone"
 	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
 	"github.com/10Narratives/task-tracker/internal/services/tasks"
 	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
 	"github.com/stretchr/testify/assert"
@@ -24,11 +27,8 @@ func TestTaskService_Register(t *testing.T) {
 	)
 
 	type args struct {
-		ctx     context.Context
-		date    string
-		title   string
-		comment string
-		repeat  string
+		ctx  context.Context
+		task models.Task
 	}
 
 	tests := []struct {
@@ -39,18 +39,18 @@ func TestTaskService_Register(t *testing.T) {
 		wantErr    require.ErrorAssertionFunc
 	}{
 		{
-			name: "successful registration",
+			name: "successful registration - anchored to due date by default",
 			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
 				m.
-					On("Create", ctx, date, title, comment, repeat).
+					On("Create", ctx, models.Task{Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: models.AnchorDue, Priority: models.PriorityNormal, Status: models.StatusTodo}).
 					Return(id, nil)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Date: date, Title: title}, nil)
+				recordsOperation(m)
 			},
 			args: args{
-				ctx:     context.Background(),
-				date:    date,
-				title:   title,
-				comment: comment,
-				repeat:  repeat,
+				ctx:  context.Background(),
+				task: models.Task{Date: date, Title: title, Comment: comment, Repeat: repeat},
 			},
 			wantResult: func(tt require.TestingT, got interface{}, _ ...interface{}) {
 				gotID, ok := got.(int64)
@@ -59,19 +59,56 @@ func TestTaskService_Register(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "successful registration - tags are normalized and attached",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.
+					On("Create", ctx, models.Task{Date: date, Title: title, Anchor: models.AnchorDue, Priority: models.PriorityNormal, Status: models.StatusTodo, Tags: []string{"home", "work"}}).
+					Return(id, nil)
+				m.On("SetTags", ctx, id, []string{"home", "work"}).Return(nil)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Date: date, Title: title, Tags: []string{"home", "work"}}, nil)
+				recordsOperation(m)
+			},
+			args: args{
+				ctx:  context.Background(),
+				task: models.Task{Date: date, Title: title, Tags: []string{" Work", "home", "work", ""}},
+			},
+			wantResult: func(tt require.TestingT, got interface{}, _ ...interface{}) {
+				gotID, ok := got.(int64)
+				require.True(t, ok)
+				assert.Equal(t, id, gotID)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "unsuccessful registration - project is archived",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("ReadProject", ctx, int64(2)).Return(models.Project{ID: 2, Name: "Garden", Archived: true}, nil)
+			},
+			args: args{
+				ctx:  context.Background(),
+				task: models.Task{Date: date, Title: title, ProjectID: 2},
+			},
+			wantResult: func(tt require.TestingT, got interface{}, _ ...interface{}) {
+				assert.Equal(t, int64(0), got)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.ErrorIs(t, err, tasks.ErrProjectArchived)
+			},
+		},
 		{
 			name: "unsuccessful registration - database error is occurred",
 			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
 				m.
-					On("Create", ctx, date, title, comment, repeat).
+					On("Create", ctx, models.Task{Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: models.AnchorCompletion, Priority: models.PriorityUrgent, Status: models.StatusBlocked}).
 					Return(int64(0), errors.New("database error"))
 			},
 			args: args{
-				ctx:     context.Background(),
-				date:    date,
-				title:   title,
-				comment: comment,
-				repeat:  repeat,
+				ctx:  context.Background(),
+				task: models.Task{Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: models.AnchorCompletion, Priority: models.PriorityUrgent, Status: models.StatusBlocked},
 			},
 			wantResult: func(tt require.TestingT, got interface{}, _ ...interface{}) {
 				gotID, ok := got.(int64)
@@ -94,7 +131,7 @@ func TestTaskService_Register(t *testing.T) {
 			tc.mockSetup(storage)
 
 			service := tasks.New(storage)
-			id, err := service.Register(tc.args.ctx, tc.args.date, tc.args.title, tc.args.comment, tc.args.repeat)
+			id, err := service.Register(tc.args.ctx, tc.args.task)
 			tc.wantErr(t, err)
 			tc.wantResult(t, id)
 
@@ -136,6 +173,7 @@ func TestTaskService_Task(t *testing.T) {
 					Comment: comment,
 					Repeat:  repeat,
 				}, nil)
+				m.On("ReadChildren", ctx, id).Return([]models.Task{{ID: id + 1, Title: "Step", ParentID: id}}, nil)
 			},
 			args: args{
 				ctx: ctx,
@@ -157,6 +195,7 @@ func TestTaskService_Task(t *testing.T) {
 				assert.Equal(t, title, task.Title)
 				assert.Equal(t, comment, task.Comment)
 				assert.Equal(t, repeat, task.Repeat)
+				assert.Equal(t, []models.Task{{ID: id + 1, Title: "Step", ParentID: id}}, task.Children)
 			},
 			wantErr: require.NoError,
 		},
@@ -185,6 +224,24 @@ func TestTaskService_Task(t *testing.T) {
 				assert.EqualError(t, err, "database error")
 			},
 		},
+		{
+			name: "unsuccessful reading - task does not exist",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", ctx, id).Return(models.Task{}, errNotFound)
+			},
+			args: args{
+				ctx: ctx,
+				id:  id,
+			},
+			wantResult: func(tt require.TestingT, got interface{}, _ ...interface{}) {
+				assert.Equal(t, models.Task{}, got)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.ErrorIs(t, err, tasks.ErrTaskNotFound)
+				assert.ErrorIs(t, err, domain.ErrNotFound)
+				assert.EqualError(t, err, "task not found: 1")
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -210,6 +267,7 @@ func TestTaskService_Tasks(t *testing.T) {
 	type args struct {
 		ctx    context.Context
 		search string
+		filter models.TaskFilter
 	}
 
 	tests := []struct {
@@ -223,7 +281,7 @@ func TestTaskService_Tasks(t *testing.T) {
 			name: "successful reading of task group",
 			mockSetup: func(m *mocks.TaskStorage) {
 				m.
-					On("ReadGroup", mock.Anything).
+					On("ReadGroup", mock.Anything, models.TaskFilter{}).
 					Return([]models.Task{
 						{ID: 1, Date: "20250206", Title: "Task 1", Comment: "Task 1 comment", Repeat: "d 7"},
 						{ID: 2, Date: "20250206", Title: "Task 2", Comment: "Task 2 comment", Repeat: "d 7"},
@@ -267,7 +325,7 @@ func TestTaskService_Tasks(t *testing.T) {
 			name: "successful reading of task group by date",
 			mockSetup: func(m *mocks.TaskStorage) {
 				m.
-					On("ReadByDate", mock.Anything, "20250206").
+					On("ReadByDate", mock.Anything, "20250206", models.TaskFilter{Status: models.StatusTodo}).
 					Return([]models.Task{
 						{ID: 1, Date: "20250206", Title: "Task 1", Comment: "Task 1 comment", Repeat: "d 7"},
 						{ID: 2, Date: "20250206", Title: "Task 2", Comment: "Task 2 comment", Repeat: "d 7"},
@@ -275,7 +333,7 @@ func TestTaskService_Tasks(t *testing.T) {
 						{ID: 4, Date: "20250206", Title: "Task 4", Comment: "Task 4 comment", Repeat: "d 7"},
 					}, nil)
 			},
-			args: args{search: "06.02.2025"},
+			args: args{search: "06.02.2025", filter: models.TaskFilter{Status: models.StatusTodo}},
 			wantResult: func(tt require.TestingT, got interface{}, _ ...interface{}) {
 				tasks, ok := got.([]models.Task)
 				require.True(t, ok)
@@ -312,7 +370,7 @@ func TestTaskService_Tasks(t *testing.T) {
 			name: "successful reading of task group by payload",
 			mockSetup: func(m *mocks.TaskStorage) {
 				m.
-					On("ReadByPayload", mock.Anything, "Task").
+					On("ReadByPayload", mock.Anything, "Task", models.TaskFilter{Priority: models.PriorityUrgent, Sort: models.SortByPriority}).
 					Return([]models.Task{
 						{ID: 1, Date: "20250206", Title: "Task 1", Comment: "Task 1 comment", Repeat: "d 7"},
 						{ID: 2, Date: "20250206", Title: "Task 2", Comment: "Task 2 comment", Repeat: "d 7"},
@@ -320,7 +378,7 @@ func TestTaskService_Tasks(t *testing.T) {
 						{ID: 4, Date: "20250206", Title: "Task 4", Comment: "Task 4 comment", Repeat: "d 7"},
 					}, nil)
 			},
-			args: args{search: "Task"},
+			args: args{search: "Task", filter: models.TaskFilter{Priority: models.PriorityUrgent, Sort: models.SortByPriority}},
 			wantResult: func(tt require.TestingT, got interface{}, _ ...interface{}) {
 				tasks, ok := got.([]models.Task)
 				require.True(t, ok)
@@ -365,7 +423,7 @@ func TestTaskService_Tasks(t *testing.T) {
 			tc.mockSetup(storage)
 
 			service := tasks.New(storage)
-			tasks, err := service.Tasks(tc.args.ctx, tc.args.search)
+			tasks, err := service.Tasks(tc.args.ctx, tc.args.search, tc.args.filter)
 			tc.wantResult(t, tasks)
 			tc.wantErr(t, err)
 
@@ -381,8 +439,9 @@ func TestTaskService_Delete(t *testing.T) {
 	)
 
 	type args struct {
-		ctx context.Context
-		id  int64
+		ctx     context.Context
+		id      int64
+		version int64
 	}
 
 	tests := []struct {
@@ -395,14 +454,43 @@ func TestTaskService_Delete(t *testing.T) {
 		{
 			name: "successful deletion",
 			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id}, nil)
 				m.On("Delete", ctx, id).Return(nil)
+				recordsOperation(m)
 			},
 			args:    args{ctx: ctx, id: id},
 			wantErr: require.NoError,
 		},
+		{
+			name: "successful deletion - current version",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Version: 3}, nil)
+				m.On("Delete", ctx, id).Return(nil)
+				recordsOperation(m)
+			},
+			args:    args{ctx: ctx, id: id, version: 3},
+			wantErr: require.NoError,
+		},
+		{
+			name: "unsuccessful deletion - task has changed",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Version: 4}, nil)
+			},
+			args: args{ctx: ctx, id: id, version: 3},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.ErrorIs(t, err, tasks.ErrVersionMismatch)
+				assert.ErrorIs(t, err, domain.ErrPrecondition)
+				assert.EqualError(t, err, "task was changed in the meantime: version 3 is not the current version 4")
+			},
+		},
 		{
 			name: "unsuccessful deletion - database error is occurred",
 			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id}, nil)
 				m.On("Delete", ctx, id).Return(errors.New("database error"))
 			},
 			args: args{ctx: ctx, id: id},
@@ -410,6 +498,17 @@ func TestTaskService_Delete(t *testing.T) {
 				assert.EqualError(t, err, "database error")
 			},
 		},
+		{
+			name: "unsuccessful deletion - task does not exist",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{}, errNotFound)
+			},
+			args: args{ctx: ctx, id: id},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.ErrorIs(t, err, tasks.ErrTaskNotFound)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -422,7 +521,7 @@ func TestTaskService_Delete(t *testing.T) {
 			tc.mockSetup(storage)
 
 			service := tasks.New(storage)
-			err := service.Delete(tc.args.ctx, tc.args.id)
+			err := service.Delete(tc.args.ctx, tc.args.id, tc.args.version)
 			//	tc.wantResult(t, tasks)
 			tc.wantErr(t, err)
 
@@ -455,16 +554,135 @@ func TestTaskService_Update(t *testing.T) {
 		{
 			name: "successful update",
 			mockSetup: func(m *mocks.TaskStorage) {
-				m.On("Update", ctx, &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat}).Return(nil)
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Repeat: "d 1", Occurrence: 4, Priority: models.PriorityHigh, Status: models.StatusInProgress}, nil)
+				m.On("Update", ctx, &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: models.AnchorDue, Occurrence: 1, Priority: models.PriorityUrgent, Status: models.StatusInProgress}).Return(nil)
+				recordsOperation(m)
+			},
+			args:    args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat, Priority: models.PriorityUrgent}},
+			wantErr: require.NoError,
+		},
+		{
+			name: "successful update - same rule keeps occurrence, priority and status are kept",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Repeat: repeat, Occurrence: 4, Priority: models.PriorityLow, Status: models.StatusBlocked}, nil)
+				m.On("Update", ctx, &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: models.AnchorCompletion, Occurrence: 4, Priority: models.PriorityLow, Status: models.StatusBlocked}).Return(nil)
+				recordsOperation(m)
+			},
+			args:    args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: models.AnchorCompletion}},
+			wantErr: require.NoError,
+		},
+		{
+			name: "successful update - anchor is kept",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Repeat: repeat, Anchor: models.AnchorCompletion, Occurrence: 3, Priority: models.PriorityLow, Status: models.StatusTodo}, nil)
+				m.On("Update", ctx, &models.Task{ID: id, Date: date, Title: title, Repeat: repeat, Anchor: models.AnchorCompletion, Occurrence: 3, Priority: models.PriorityHigh, Status: models.StatusTodo}).Return(nil)
+				recordsOperation(m)
+			},
+			args:    args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Repeat: repeat, Priority: models.PriorityHigh}},
+			wantErr: require.NoError,
+		},
+		{
+			name: "successful update - project is kept",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Repeat: repeat, Occurrence: 1, Priority: models.PriorityLow, Status: models.StatusTodo, ProjectID: 2}, nil)
+				m.On("Update", ctx, &models.Task{ID: id, Date: date, Title: title, Repeat: repeat, Anchor: models.AnchorDue, Occurrence: 1, Priority: models.PriorityLow, Status: models.StatusTodo, ProjectID: 2}).Return(nil)
+				recordsOperation(m)
+			},
+			args:    args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Repeat: repeat}},
+			wantErr: require.NoError,
+		},
+		{
+			name: "successful update - time, exception dates and reminders are kept",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Time: "09:30", Repeat: repeat, ExDates: []string{"20250305"}, Occurrence: 1, Priority: models.PriorityLow, Status: models.StatusTodo, Reminders: []int{15}}, nil)
+				m.On("Update", ctx, &models.Task{ID: id, Date: date, Time: "09:30", Title: title, Repeat: repeat, Anchor: models.AnchorDue, ExDates: []string{"20250305"}, Occurrence: 1, Priority: models.PriorityLow, Status: models.StatusTodo, Reminders: []int{15}}).Return(nil)
+				recordsOperation(m)
+			},
+			args:    args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Repeat: repeat}},
+			wantErr: require.NoError,
+		},
+		{
+			name: "successful update - time, exception dates and reminders are cleared",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Time: "09:30", Repeat: repeat, ExDates: []string{"20250305"}, Occurrence: 1, Priority: models.PriorityLow, Status: models.StatusTodo, Reminders: []int{15}}, nil)
+				m.On("Update", ctx, &models.Task{ID: id, Date: date, ClearTime: true, Title: title, Repeat: repeat, Anchor: models.AnchorDue, ExDates: []string{}, Occurrence: 1, Priority: models.PriorityLow, Status: models.StatusTodo, Reminders: []int{}}).Return(nil)
+				recordsOperation(m)
 			},
-			args:    args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat}},
+			args:    args{ctx: ctx, task: &models.Task{ID: id, Date: date, ClearTime: true, Title: title, Repeat: repeat, ExDates: []string{}, Reminders: []int{}}},
 			wantErr: require.NoError,
 		},
+		{
+			name: "unsuccessful update - task has changed",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Repeat: repeat, Occurrence: 1, Version: 5}, nil)
+			},
+			args: args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Repeat: repeat, Version: 4}},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.ErrorIs(t, err, tasks.ErrVersionMismatch)
+			},
+		},
+		{
+			name: "unsuccessful update - task changed by a concurrent writer",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Repeat: repeat, Occurrence: 1, Priority: models.PriorityNormal, Status: models.StatusTodo, Version: 5}, nil)
+				m.On("Update", ctx, mock.MatchedBy(func(task *models.Task) bool { return task.Version == 5 })).
+					Return(domain.New(domain.ErrPrecondition, "task 100 was changed in the meantime: version 5 is not the current one"))
+			},
+			args: args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Repeat: repeat}},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.ErrorIs(t, err, tasks.ErrVersionMismatch)
+			},
+		},
+		{
+			name: "unsuccessful update - new project does not exist",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Repeat: repeat, Occurrence: 1, ProjectID: 2}, nil)
+				m.On("ReadProject", ctx, int64(3)).Return(models.Project{}, errNotFound)
+			},
+			args: args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Repeat: repeat, ProjectID: 3}},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.ErrorIs(t, err, tasks.ErrProjectNotFound)
+			},
+		},
+		{
+			name: "successful update - tags are replaced",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Repeat: repeat, Occurrence: 1, Priority: models.PriorityLow, Status: models.StatusTodo, Tags: []string{"home"}}, nil)
+				m.On("Update", ctx, &models.Task{ID: id, Date: date, Title: title, Repeat: repeat, Anchor: models.AnchorDue, Occurrence: 1, Priority: models.PriorityLow, Status: models.StatusTodo, Tags: []string{}}).Return(nil)
+				m.On("SetTags", ctx, id, []string{}).Return(nil)
+				recordsOperation(m)
+			},
+			args:    args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Repeat: repeat, Tags: []string{}}},
+			wantErr: require.NoError,
+		},
+		{
+			name: "unsuccessful update - database error is occurred on read",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{}, errors.New("database error"))
+			},
+			args: args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat}},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.EqualError(t, err, "database error")
+			},
+		},
 		{
 			name: "unsuccessful update - database error is occurred",
 			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.On("Read", ctx, id).Return(models.Task{ID: id, Repeat: repeat, Occurrence: 1}, nil)
 				m.
-					On("Update", ctx, &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat}).
+					On("Update", ctx, &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: models.AnchorDue, Occurrence: 1}).
 					Return(errors.New("database error"))
 			},
 			args: args{ctx: ctx, task: &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat}},
@@ -484,7 +702,7 @@ func TestTaskService_Update(t *testing.T) {
 			tc.mockSetup(storage)
 
 			service := tasks.New(storage)
-			err := service.Update(tc.args.ctx, id, date, title, comment, repeat)
+			err := service.Update(tc.args.ctx, *tc.args.task)
 			tc.wantErr(t, err)
 
 			storage.AssertExpectations(t)
@@ -507,9 +725,15 @@ func TestTaskService_Complete(t *testing.T) {
 		{
 			name: "successful complete - with nextdate",
 			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
 				m.
 					On("Read", mock.Anything, int64(100)).
 					Return(models.Task{ID: 100, Date: "20250402", Title: "Title", Comment: "Comment", Repeat: "d 7"}, nil)
+				m.
+					On("CreateCompletion", mock.Anything, mock.Anything).
+					Return(int64(1), nil)
+				recordsOperation(m)
+				withoutSubtasks(m)
 				m.
 					On("Update", mock.Anything, mock.Anything).
 					Return(nil)
@@ -520,6 +744,7 @@ func TestTaskService_Complete(t *testing.T) {
 		{
 			name: "unsuccessful complete - with nextdate",
 			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
 				m.
 					On("Read", mock.Anything, int64(100)).
 					Return(models.Task{}, errors.New("database error"))
@@ -529,12 +754,93 @@ func TestTaskService_Complete(t *testing.T) {
 				assert.EqualError(t, err, "database error")
 			},
 		},
+		{
+			name: "unsuccessful complete - invalid repeat rule",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250402", Title: "Title", Comment: "Comment", Repeat: "d 0"}, nil)
+				m.
+					On("CreateCompletion", mock.Anything, mock.Anything).
+					Return(int64(1), nil)
+				recordsOperation(m)
+				withoutSubtasks(m)
+			},
+			args: args{ctx: context.Background(), id: 100},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.EqualError(t, err, `invalid repeat rule "d 0" at position 3: 0 is not a valid day interval`)
+			},
+		},
+		{
+			name: "successful complete - next occurrence is counted",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250402", Title: "Title", Repeat: "d 7 count=3", Occurrence: 2}, nil)
+				m.
+					On("CreateCompletion", mock.Anything, mock.Anything).
+					Return(int64(1), nil)
+				recordsOperation(m)
+				withoutSubtasks(m)
+				m.
+					On("Update", mock.Anything, mock.MatchedBy(func(task *models.Task) bool { return task.Occurrence == 3 })).
+					Return(nil)
+			},
+			args:    args{ctx: context.Background(), id: 100},
+			wantErr: require.NoError,
+		},
+		{
+			name: "successful complete - last counted occurrence",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250402", Title: "Title", Repeat: "d 7 count=3", Occurrence: 3}, nil)
+				m.
+					On("CreateCompletion", mock.Anything, mock.Anything).
+					Return(int64(1), nil)
+				recordsOperation(m)
+				withoutSubtasks(m)
+				m.
+					On("Delete", mock.Anything, int64(100)).
+					Return(nil)
+			},
+			args:    args{ctx: context.Background(), id: 100},
+			wantErr: require.NoError,
+		},
+		{
+			name: "successful complete - until date has passed",
+			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250402", Title: "Title", Repeat: "d 7 until=20250405", Occurrence: 1}, nil)
+				m.
+					On("CreateCompletion", mock.Anything, mock.Anything).
+					Return(int64(1), nil)
+				recordsOperation(m)
+				withoutSubtasks(m)
+				m.
+					On("Delete", mock.Anything, int64(100)).
+					Return(nil)
+			},
+			args:    args{ctx: context.Background(), id: 100},
+			wantErr: require.NoError,
+		},
 		{
 			name: "successful complete - without nextdate",
 			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
 				m.
 					On("Read", mock.Anything, int64(100)).
 					Return(models.Task{ID: 100, Date: "20250402", Title: "Title", Comment: "Comment", Repeat: ""}, nil)
+				m.
+					On("CreateCompletion", mock.Anything, mock.Anything).
+					Return(int64(1), nil)
+				recordsOperation(m)
+				withoutSubtasks(m)
 				m.
 					On("Delete", mock.Anything, int64(100)).
 					Return(nil)
@@ -545,9 +851,15 @@ func TestTaskService_Complete(t *testing.T) {
 		{
 			name: "unsuccessful complete - without nextdate",
 			mockSetup: func(m *mocks.TaskStorage) {
+				passThroughTx(m)
 				m.
 					On("Read", mock.Anything, int64(100)).
 					Return(models.Task{ID: 100, Date: "20250402", Title: "Title", Comment: "Comment", Repeat: ""}, nil)
+				m.
+					On("CreateCompletion", mock.Anything, mock.Anything).
+					Return(int64(1), nil)
+				recordsOperation(m)
+				withoutSubtasks(m)
 				m.
 					On("Delete", mock.Anything, int64(100)).
 					Return(errors.New("database error"))
@@ -566,13 +878,538 @@ func TestTaskService_Complete(t *testing.T) {
 			t.Parallel()
 
 			storage := mocks.NewTaskStorage(t)
+			withoutBlockers(storage)
 			tc.mockSetup(storage)
 
 			service := tasks.New(storage)
-			err := service.Complete(tc.args.ctx, tc.args.id)
+			err := service.Complete(tc.args.ctx, tc.args.id, 0, false)
+			tc.wantErr(t, err)
+
+			storage.AssertExpectations(t)
+		})
+	}
+}
+
+// passThroughTx makes the storage mock run transactions without any transaction handling.
+func passThroughTx(m *mocks.TaskStorage) {
+	m.
+		On("InTx", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+}
+
+// withoutSubtasks makes the storage mock report that tasks have no subtasks and accept resetting them.
+func withoutSubtasks(m *mocks.TaskStorage) {
+	m.
+		On("ReadChildren", mock.Anything, mock.Anything).
+		Return([]models.Task{}, nil).
+		Maybe()
+	m.
+		On("ResetChildren", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Maybe()
+}
+
+// withoutBlockers makes the storage mock report that tasks have no open blockers.
+func withoutBlockers(m *mocks.TaskStorage) {
+	m.
+		On("OpenBlockers", mock.Anything, mock.Anything).
+		Return([]int64(nil), nil).
+		Maybe()
+}
+
+// recordsOperation makes the storage mock accept a recorded operation and the audit entry made once the change succeeds.
+func recordsOperation(m *mocks.TaskStorage) {
+	m.
+		On("CreateOperation", mock.Anything, mock.Anything).
+		Return(int64(1), nil)
+	m.
+		On("DeleteOperations", mock.Anything, mock.Anything).
+		Return(nil)
+	m.
+		On("CreateAuditEntry", mock.Anything, mock.Anything).
+		Return(int64(1), nil).
+		Maybe()
+}
+
+// errNotFound is what the storage mock returns for a missing record.
+var errNotFound = domain.New(domain.ErrNotFound, "record not found")
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestTaskService_Complete_TimeZone(t *testing.T) {
+	// 23:30 UTC on April 1st is already April 2nd in Tokyo.
+	clock := fixedClock(time.Date(2025, 4, 1, 23, 30, 0, 0, time.UTC))
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		opts     []tasks.Option
+		wantDate string
+	}{
+		{
+			name:     "default zone is UTC",
+			ctx:      context.Background(),
+			opts:     []tasks.Option{tasks.WithClock(clock)},
+			wantDate: "20250402",
+		},
+		{
+			name:     "configured default zone",
+			ctx:      context.Background(),
+			opts:     []tasks.Option{tasks.WithClock(clock), tasks.WithLocation(tokyo)},
+			wantDate: "20250403",
+		},
+		{
+			name:     "request zone overrides default zone",
+			ctx:      timezone.WithLocation(context.Background(), tokyo),
+			opts:     []tasks.Option{tasks.WithClock(clock)},
+			wantDate: "20250403",
+		},
+		{
+			name:     "request zone behind UTC",
+			ctx:      timezone.WithLocation(context.Background(), time.FixedZone("EST", -5*60*60)),
+			opts:     []tasks.Option{tasks.WithClock(clock), tasks.WithLocation(tokyo)},
+			wantDate: "20250402",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			withoutSubtasks(storage)
+			withoutBlockers(storage)
+			storage.
+				On("CreateCompletion", mock.Anything, mock.Anything).
+				Return(int64(1), nil)
+			recordsOperation(storage)
+			storage.
+				On("Read", mock.Anything, int64(100)).
+				Return(models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 1", Status: models.StatusDone}, nil)
+			storage.
+				On("Update", mock.Anything, &models.Task{ID: 100, Date: tc.wantDate, Title: "Title", Repeat: "d 1", Occurrence: 2, Status: models.StatusTodo}).
+				Return(nil)
+
+			service := tasks.New(storage, tc.opts...)
+			err := service.Complete(tc.ctx, 100, 0, false)
+			require.NoError(t, err)
+
+			storage.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskService_Complete_Anchor(t *testing.T) {
+	// The task was due on April 1st and is completed nine days late.
+	clock := fixedClock(time.Date(2025, 4, 10, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		anchor   string
+		exDates  []string
+		wantDate string
+	}{
+		{
+			name:     "due date anchor keeps the schedule",
+			anchor:   models.AnchorDue,
+			wantDate: "20250413",
+		},
+		{
+			name:     "completion anchor counts from today",
+			anchor:   models.AnchorCompletion,
+			wantDate: "20250414",
+		},
+		{
+			name:     "completion anchor jumps over exception dates",
+			anchor:   models.AnchorCompletion,
+			exDates:  []string{"20250414"},
+			wantDate: "20250418",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			withoutSubtasks(storage)
+			withoutBlockers(storage)
+			storage.
+				On("CreateCompletion", mock.Anything, mock.Anything).
+				Return(int64(1), nil)
+			recordsOperation(storage)
+			storage.
+				On("Read", mock.Anything, int64(100)).
+				Return(models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4", Anchor: tc.anchor, ExDates: tc.exDates, Occurrence: 1}, nil)
+			storage.
+				On("Update", mock.Anything, &models.Task{ID: 100, Date: tc.wantDate, Title: "Title", Repeat: "d 4", Anchor: tc.anchor, ExDates: tc.exDates, Occurrence: 2, Status: models.StatusTodo}).
+				Return(nil)
+
+			service := tasks.New(storage, tasks.WithClock(clock))
+			err := service.Complete(context.Background(), 100, 0, false)
+			require.NoError(t, err)
+
+			storage.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskService_Skip(t *testing.T) {
+	clock := fixedClock(time.Date(2025, 4, 10, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name      string
+		mockSetup func(m *mocks.TaskStorage)
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "successful skip - moves past the current date only",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4", Occurrence: 1}, nil)
+				m.
+					On("Update", mock.Anything, &models.Task{ID: 100, Date: "20250405", Title: "Title", Repeat: "d 4", Occurrence: 2, Status: models.StatusTodo}).
+					Return(nil)
+				m.
+					On("CreateAuditEntry", mock.Anything, models.AuditEntry{
+						Actor:     "system",
+						Operation: models.OperationSkip,
+						TaskID:    100,
+						Before:    &models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4", Occurrence: 1},
+						After:     &models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4", Occurrence: 1},
+						CreatedAt: "2025-04-10T12:00:00Z",
+					}).
+					Return(int64(1), nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "successful skip - exception dates are jumped over",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4", ExDates: []string{"20250405"}, Occurrence: 1}, nil)
+				m.
+					On("Update", mock.Anything, &models.Task{ID: 100, Date: "20250409", Title: "Title", Repeat: "d 4", ExDates: []string{"20250405"}, Occurrence: 2, Status: models.StatusTodo}).
+					Return(nil)
+				m.
+					On("CreateAuditEntry", mock.Anything, models.AuditEntry{
+						Actor:     "system",
+						Operation: models.OperationSkip,
+						TaskID:    100,
+						Before:    &models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4", ExDates: []string{"20250405"}, Occurrence: 1},
+						After:     &models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4", ExDates: []string{"20250405"}, Occurrence: 1},
+						CreatedAt: "2025-04-10T12:00:00Z",
+					}).
+					Return(int64(1), nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "successful skip - last occurrence deletes the task",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4 count=2", Occurrence: 2}, nil)
+				m.
+					On("Delete", mock.Anything, int64(100)).
+					Return(nil)
+				m.
+					On("CreateAuditEntry", mock.Anything, models.AuditEntry{
+						Actor:     "system",
+						Operation: models.OperationSkip,
+						TaskID:    100,
+						Before:    &models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4 count=2", Occurrence: 2},
+						After:     &models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4 count=2", Occurrence: 2},
+						CreatedAt: "2025-04-10T12:00:00Z",
+					}).
+					Return(int64(1), nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "unsuccessful skip - task is not recurring",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250401", Title: "Title"}, nil)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.ErrorIs(t, err, tasks.ErrNotRecurring)
+			},
+		},
+		{
+			name: "unsuccessful skip - invalid exception date",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250401", Title: "Title", Repeat: "d 4", ExDates: []string{"2025-04-05"}}, nil)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.ErrorContains(t, err, `task has invalid exception date "2025-04-05"`)
+			},
+		},
+		{
+			name: "unsuccessful skip - database error",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{}, errors.New("database error"))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				assert.EqualError(t, err, "database error")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			withoutSubtasks(storage)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage, tasks.WithClock(clock))
+			err := service.Skip(context.Background(), 100)
 			tc.wantErr(t, err)
 
 			storage.AssertExpectations(t)
 		})
 	}
 }
+
+func TestTaskService_Complete_RecordsCompletion(t *testing.T) {
+	clock := fixedClock(time.Date(2025, 4, 10, 12, 30, 0, 0, time.FixedZone("CEST", 2*60*60)))
+
+	storage := mocks.NewTaskStorage(t)
+	passThroughTx(storage)
+	withoutBlockers(storage)
+	storage.
+		On("Read", mock.Anything, int64(100)).
+		Return(models.Task{ID: 100, Date: "20250410", Title: "Title"}, nil).
+		Once()
+	storage.
+		On("CreateCompletion", mock.Anything, models.Completion{TaskID: 100, Title: "Title", Date: "20250410", CompletedAt: "2025-04-10T10:30:00Z"}).
+		Return(int64(1), nil)
+	storage.
+		On("CreateOperation", mock.Anything, models.Operation{
+			Kind:         models.OperationComplete,
+			TaskID:       100,
+			Task:         &models.Task{ID: 100, Date: "20250410", Title: "Title"},
+			CompletionID: 1,
+			CreatedAt:    "2025-04-10T10:30:00Z",
+		}).
+		Return(int64(1), nil)
+	storage.
+		On("DeleteOperations", mock.Anything, "2025-04-10T10:20:00Z").
+		Return(nil)
+	storage.
+		On("Delete", mock.Anything, int64(100)).
+		Return(nil)
+	storage.
+		On("Read", mock.Anything, int64(100)).
+		Return(models.Task{}, errNotFound).
+		Once()
+	storage.
+		On("CreateAuditEntry", mock.Anything, models.AuditEntry{
+			Actor:     "system",
+			Operation: models.OperationComplete,
+			TaskID:    100,
+			Before:    &models.Task{ID: 100, Date: "20250410", Title: "Title"},
+			CreatedAt: "2025-04-10T10:30:00Z",
+		}).
+		Return(int64(1), nil)
+
+	service := tasks.New(storage, tasks.WithClock(clock))
+	err := service.Complete(context.Background(), 100, 0, false)
+	require.NoError(t, err)
+
+	storage.AssertExpectations(t)
+}
+
+func TestTaskService_History(t *testing.T) {
+	history := []models.Completion{{ID: 1, TaskID: 100, Title: "Title", Date: "20250410", CompletedAt: "2025-04-10T10:30:00Z"}}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(m *mocks.TaskStorage)
+		wantHistory []models.Completion
+		wantErr     require.ErrorAssertionFunc
+	}{
+		{
+			name: "history of a task",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(100)).Return(models.Task{ID: 100}, nil)
+				m.On("ReadCompletions", mock.Anything, models.CompletionFilter{TaskID: 100}).Return(history, nil)
+			},
+			wantHistory: history,
+			wantErr:     require.NoError,
+		},
+		{
+			name: "task not found",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("Read", mock.Anything, int64(100)).Return(models.Task{}, errNotFound)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, tasks.ErrTaskNotFound, i...)
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			storage := mocks.NewTaskStorage(t)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage)
+			got, err := service.History(context.Background(), 100)
+			tc.wantErr(t, err)
+			assert.Equal(t, tc.wantHistory, got)
+		})
+	}
+}
+
+func TestTaskService_Completions(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		from       string
+		to         string
+		wantFilter models.CompletionFilter
+		wantErr    string
+	}{
+		{
+			name:       "open range",
+			ctx:        context.Background(),
+			wantFilter: models.CompletionFilter{},
+		},
+		{
+			name:       "dates are whole days in UTC",
+			ctx:        context.Background(),
+			from:       "20250407",
+			to:         "20250413",
+			wantFilter: models.CompletionFilter{From: "2025-04-07T00:00:00Z", To: "2025-04-14T00:00:00Z"},
+		},
+		{
+			name:       "dates are whole days in the request zone",
+			ctx:        timezone.WithLocation(context.Background(), tokyo),
+			from:       "20250407",
+			to:         "20250407",
+			wantFilter: models.CompletionFilter{From: "2025-04-06T15:00:00Z", To: "2025-04-07T15:00:00Z"},
+		},
+		{
+			name:    "invalid from date",
+			ctx:     context.Background(),
+			from:    "2025-04-07",
+			wantErr: `invalid from date "2025-04-07"`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			if tc.wantErr == "" {
+				storage.
+					On("ReadCompletions", mock.Anything, tc.wantFilter).
+					Return([]models.Completion{}, nil)
+			}
+
+			service := tasks.New(storage)
+			_, err := service.Completions(tc.ctx, tc.from, tc.to)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTaskService_Restore(t *testing.T) {
+	clock := fixedClock(time.Date(2025, 4, 10, 12, 30, 0, 0, time.FixedZone("CEST", 2*60*60)))
+
+	tests := []struct {
+		name     string
+		storeErr error
+		wantErr  string
+	}{
+		{name: "restored"},
+		{name: "not in trash", storeErr: errNotFound, wantErr: "task is not in the trash: 100"},
+		{name: "storage error", storeErr: errors.New("database error"), wantErr: "database error"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			storage.
+				On("Restore", mock.Anything, int64(100)).
+				Return(tc.storeErr)
+			if tc.storeErr == nil {
+				storage.
+					On("Read", mock.Anything, int64(100)).
+					Return(models.Task{ID: 100, Date: "20250410", Title: "Title"}, nil)
+				storage.
+					On("CreateAuditEntry", mock.Anything, models.AuditEntry{
+						Actor:     "system",
+						Operation: models.OperationRestore,
+						TaskID:    100,
+						After:     &models.Task{ID: 100, Date: "20250410", Title: "Title"},
+						CreatedAt: "2025-04-10T10:30:00Z",
+					}).
+					Return(int64(1), nil)
+			}
+
+			service := tasks.New(storage, tasks.WithClock(clock))
+			err := service.Restore(context.Background(), 100)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestTaskService_Purge(t *testing.T) {
+	clock := fixedClock(time.Date(2025, 4, 10, 12, 30, 0, 0, time.FixedZone("CEST", 2*60*60)))
+
+	storage := mocks.NewTaskStorage(t)
+	storage.
+		On("Purge", mock.Anything, "2025-03-11T10:30:00Z").
+		Return(int64(2), nil)
+
+	service := tasks.New(storage, tasks.WithClock(clock))
+	purged, err := service.Purge(context.Background(), 30*24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+}
@@ -0,0 +1,119 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// defaultUndoWindow is how long an operation can be undone for unless WithUndoWindow says otherwise.
+const defaultUndoWindow = 10 * time.Minute
+
+// ErrNothingToUndo is returned when no operation was made within the undo window.
+var ErrNothingToUndo = domain.New(domain.ErrNotFound, "nothing to undo")
+
+// record saves a reversible operation and forgets the operations which can no longer be undone.
+func (service TaskService) record(ctx context.Context, op models.Operation) error {
+	now := service.clock.Now().UTC()
+	op.CreatedAt = now.Format(time.RFC3339)
+	if _, err := service.storage.CreateOperation(ctx, op); err != nil {
+		return err
+	}
+	return service.storage.DeleteOperations(ctx, now.Add(-service.undoWindow).Format(time.RFC3339))
+}
+
+// Undo reverts the most recent register, update, delete or complete operation made within the undo window.
+// Every call goes one operation further back. It returns the reverted operation,
+// or ErrNothingToUndo if there is none. All changes are made in a single transaction.
+func (service TaskService) Undo(ctx context.Context) (models.Operation, error) {
+	var op models.Operation
+	err := service.storage.InTx(ctx, func(ctx context.Context) error {
+		since := service.clock.Now().UTC().Add(-service.undoWindow).Format(time.RFC3339)
+
+		var err error
+		op, err = service.storage.LastOperation(ctx, since)
+		if err != nil {
+			return err
+		}
+		if op.ID == 0 {
+			return ErrNothingToUndo
+		}
+
+		// The task may be in the trash or gone, in which case there is no state before the undo to log.
+		var before *models.Task
+		current, err := service.storage.Read(ctx, op.TaskID)
+		switch {
+		case err == nil:
+			before = &current
+		case !errors.Is(err, domain.ErrNotFound):
+			return err
+		}
+
+		if err := service.revert(ctx, op); err != nil {
+			return err
+		}
+		if err := service.storage.DeleteOperation(ctx, op.ID); err != nil {
+			return err
+		}
+		return service.audit(ctx, models.OperationUndo, op.TaskID, before)
+	})
+	if err != nil {
+		return models.Operation{}, err
+	}
+	return op, nil
+}
+
+// revert brings the task touched by the operation back to its previous state.
+func (service TaskService) revert(ctx context.Context, op models.Operation) error {
+	switch op.Kind {
+	case models.OperationRegister:
+		// The task may have been purged or moved to the trash since, which leaves nothing to undo.
+		return ignoreNotFound(service.storage.Delete(ctx, op.TaskID))
+	case models.OperationUpdate:
+		// The snapshot is written back over whatever the task went through since, so its old version is not checked.
+		op.Task.Version = 0
+		err := service.storage.Update(ctx, op.Task)
+		if errors.Is(err, domain.ErrNotFound) {
+			// The task was rolled over to its end or purged since, so there is nothing to write back.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return service.storage.SetTags(ctx, op.TaskID, op.Task.Tags)
+	case models.OperationDelete:
+		return service.storage.Restore(ctx, op.TaskID)
+	case models.OperationComplete:
+		// A completed task is either rescheduled or moved to the trash,
+		// so it is taken out of the trash before its previous state is written back.
+		// A rescheduled task is not in the trash.
+		if err := ignoreNotFound(service.storage.Restore(ctx, op.TaskID)); err != nil {
+			return err
+		}
+		op.Task.Version = 0
+		if err := service.storage.Update(ctx, op.Task); err != nil {
+			return err
+		}
+		for _, child := range op.Task.Children {
+			child.Version = 0
+			if err := service.storage.Update(ctx, &child); err != nil {
+				return err
+			}
+		}
+		return service.storage.DeleteCompletion(ctx, op.CompletionID)
+	default:
+		return fmt.Errorf("cannot undo unknown operation %q", op.Kind)
+	}
+}
+
+// ignoreNotFound drops errors of the domain.ErrNotFound kind.
+func ignoreNotFound(err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	return err
+}
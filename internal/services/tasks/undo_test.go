@@ -0,0 +1,182 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/tasks"
+	"github.com/10Narratives/task-tracker/internal/services/tasks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTaskService_Undo(t *testing.T) {
+	clock := fixedClock(time.Date(2025, 4, 10, 12, 30, 0, 0, time.UTC))
+	snapshot := &models.Task{ID: 7, Date: "20250403", Title: "Title", Repeat: "d 7", Anchor: models.AnchorDue, Occurrence: 2, Tags: []string{"home"}}
+	current := &models.Task{ID: 7, Date: "20250410", Title: "Title", Repeat: "d 7", Anchor: models.AnchorDue, Occurrence: 3, Tags: []string{"home"}}
+
+	// audited expects the task to be read before and after the undo and the undo to be written to the audit log.
+	// A nil task stands for one which cannot be read, because it is in the trash or gone.
+	audited := func(m *mocks.TaskStorage, before, after *models.Task) {
+		for _, task := range []*models.Task{before, after} {
+			if task == nil {
+				m.On("Read", mock.Anything, int64(7)).Return(models.Task{}, errNotFound).Once()
+				continue
+			}
+			m.On("Read", mock.Anything, int64(7)).Return(*task, nil).Once()
+		}
+		m.
+			On("CreateAuditEntry", mock.Anything, models.AuditEntry{
+				Actor:     "system",
+				Operation: models.OperationUndo,
+				TaskID:    7,
+				Before:    before,
+				After:     after,
+				CreatedAt: "2025-04-10T12:30:00Z",
+			}).
+			Return(int64(1), nil)
+	}
+
+	tests := []struct {
+		name      string
+		opts      []tasks.Option
+		mockSetup func(m *mocks.TaskStorage)
+		wantOp    models.Operation
+		wantErr   error
+	}{
+		{
+			name: "nothing to undo",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("LastOperation", mock.Anything, "2025-04-10T12:20:00Z").Return(models.Operation{}, nil)
+			},
+			wantErr: tasks.ErrNothingToUndo,
+		},
+		{
+			name: "undo window is configurable",
+			opts: []tasks.Option{tasks.WithUndoWindow(time.Hour)},
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.On("LastOperation", mock.Anything, "2025-04-10T11:30:00Z").Return(models.Operation{}, nil)
+			},
+			wantErr: tasks.ErrNothingToUndo,
+		},
+		{
+			name: "registration is undone by deleting the task",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("LastOperation", mock.Anything, mock.Anything).
+					Return(models.Operation{ID: 3, Kind: models.OperationRegister, TaskID: 7}, nil)
+				m.On("Delete", mock.Anything, int64(7)).Return(nil)
+				m.On("DeleteOperation", mock.Anything, int64(3)).Return(nil)
+				audited(m, current, nil)
+			},
+			wantOp: models.Operation{ID: 3, Kind: models.OperationRegister, TaskID: 7},
+		},
+		{
+			name: "update is undone by writing the previous task back",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("LastOperation", mock.Anything, mock.Anything).
+					Return(models.Operation{ID: 3, Kind: models.OperationUpdate, TaskID: 7, Task: snapshot}, nil)
+				m.On("Update", mock.Anything, snapshot).Return(nil)
+				m.On("SetTags", mock.Anything, int64(7), []string{"home"}).Return(nil)
+				m.On("DeleteOperation", mock.Anything, int64(3)).Return(nil)
+				audited(m, current, snapshot)
+			},
+			wantOp: models.Operation{ID: 3, Kind: models.OperationUpdate, TaskID: 7, Task: snapshot},
+		},
+		{
+			name: "deletion is undone by restoring the task",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("LastOperation", mock.Anything, mock.Anything).
+					Return(models.Operation{ID: 3, Kind: models.OperationDelete, TaskID: 7, Task: snapshot}, nil)
+				m.On("Restore", mock.Anything, int64(7)).Return(nil)
+				m.On("DeleteOperation", mock.Anything, int64(3)).Return(nil)
+				audited(m, nil, snapshot)
+			},
+			wantOp: models.Operation{ID: 3, Kind: models.OperationDelete, TaskID: 7, Task: snapshot},
+		},
+		{
+			name: "completion is undone by writing the previous task back and forgetting the completion",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("LastOperation", mock.Anything, mock.Anything).
+					Return(models.Operation{ID: 3, Kind: models.OperationComplete, TaskID: 7, Task: snapshot, CompletionID: 5}, nil)
+				m.On("Restore", mock.Anything, int64(7)).Return(errNotFound)
+				m.On("Update", mock.Anything, snapshot).Return(nil)
+				m.On("DeleteCompletion", mock.Anything, int64(5)).Return(nil)
+				m.On("DeleteOperation", mock.Anything, int64(3)).Return(nil)
+				audited(m, current, snapshot)
+			},
+			wantOp: models.Operation{ID: 3, Kind: models.OperationComplete, TaskID: 7, Task: snapshot, CompletionID: 5},
+		},
+		{
+			name: "completion of a recurring task brings its subtasks back",
+			mockSetup: func(m *mocks.TaskStorage) {
+				parent := &models.Task{ID: 7, Date: "20250410", Title: "Clean", Repeat: "d 7", Children: []models.Task{
+					{ID: 8, Date: "20250410", Title: "Kitchen", Status: models.StatusDone, ParentID: 7},
+				}}
+				m.
+					On("LastOperation", mock.Anything, mock.Anything).
+					Return(models.Operation{ID: 3, Kind: models.OperationComplete, TaskID: 7, Task: parent, CompletionID: 5}, nil)
+				m.On("Restore", mock.Anything, int64(7)).Return(nil)
+				m.On("Update", mock.Anything, parent).Return(nil)
+				m.On("Update", mock.Anything, &parent.Children[0]).Return(nil)
+				m.On("DeleteCompletion", mock.Anything, int64(5)).Return(nil)
+				m.On("DeleteOperation", mock.Anything, int64(3)).Return(nil)
+				audited(m, nil, parent)
+			},
+			wantOp: models.Operation{ID: 3, Kind: models.OperationComplete, TaskID: 7, Task: &models.Task{
+				ID: 7, Date: "20250410", Title: "Clean", Repeat: "d 7", Children: []models.Task{
+					{ID: 8, Date: "20250410", Title: "Kitchen", Status: models.StatusDone, ParentID: 7},
+				},
+			}, CompletionID: 5},
+		},
+		{
+			name: "database error",
+			mockSetup: func(m *mocks.TaskStorage) {
+				m.
+					On("LastOperation", mock.Anything, mock.Anything).
+					Return(models.Operation{ID: 3, Kind: models.OperationDelete, TaskID: 7, Task: snapshot}, nil)
+				m.On("Read", mock.Anything, int64(7)).Return(models.Task{}, errNotFound)
+				m.On("Restore", mock.Anything, int64(7)).Return(errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewTaskStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			service := tasks.New(storage, append(tc.opts, tasks.WithClock(clock))...)
+			op, err := service.Undo(context.Background())
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantOp, op)
+		})
+	}
+}
+
+func TestTaskService_Undo_UnknownOperation(t *testing.T) {
+	storage := mocks.NewTaskStorage(t)
+	passThroughTx(storage)
+	storage.
+		On("LastOperation", mock.Anything, mock.Anything).
+		Return(models.Operation{ID: 3, Kind: "rename", TaskID: 7}, nil)
+	storage.
+		On("Read", mock.Anything, int64(7)).
+		Return(models.Task{ID: 7, Date: "20250410", Title: "Title"}, nil)
+
+	service := tasks.New(storage)
+	_, err := service.Undo(context.Background())
+	assert.EqualError(t, err, `cannot undo unknown operation "rename"`)
+}
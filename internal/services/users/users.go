@@ -0,0 +1,295 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BootstrapName is the name of the admin account created by Bootstrap.
+// Sign-ins which do not give a name are made with it.
+const BootstrapName = "owner"
+
+// ErrUserNotFound is returned when there is no account with the given ID.
+var ErrUserNotFound = domain.New(domain.ErrNotFound, "user not found")
+
+// ErrNameTaken is returned when an account is registered under a name another account already has.
+var ErrNameTaken = domain.New(domain.ErrConflict, "user name is already taken")
+
+// ErrLastAdmin is returned when the only admin would be removed or lose the admin rights,
+// which would leave nobody able to manage the accounts.
+var ErrLastAdmin = domain.New(domain.ErrConflict, "the last admin cannot be removed or lose admin rights")
+
+// ErrNoAdmin is returned when an account is registered before Bootstrap has created the admin.
+// Until then nobody could manage the accounts, and the tasks made before there were accounts are kept for the admin.
+var ErrNoAdmin = domain.New(domain.ErrConflict, "there is no admin account yet, start the tracker with PASSWORD to create one")
+
+// ErrWrongCredentials is returned when there is no account with the name or the password does not match.
+// Both cases are reported the same way, so that names cannot be guessed by signing in.
+var ErrWrongCredentials = errors.New("wrong user name or password")
+
+// UserStorage is an interface for working with the accounts in the storage.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.28.2 --name=UserStorage
+type UserStorage interface {
+
+	// CreateUser adds a new account and returns its ID and any error encountered.
+	CreateUser(ctx context.Context, user models.User) (int64, error)
+
+	// ReadUser retrieves an account by its ID.
+	// It returns the account and any error encountered, which is of the domain.ErrNotFound kind if there is no such account.
+	ReadUser(ctx context.Context, id int64) (models.User, error)
+
+	// ReadUserByName retrieves an account by the name the user signs in with.
+	// It returns the account and any error encountered, which is of the domain.ErrNotFound kind if there is no such account.
+	ReadUserByName(ctx context.Context, name string) (models.User, error)
+
+	// ReadUsers retrieves all accounts in alphabetical order.
+	// It returns a slice of accounts and any error encountered.
+	ReadUsers(ctx context.Context) ([]models.User, error)
+
+	// UpdateUser changes the password hash and the admin rights of an account.
+	// It returns any error encountered, which is of the domain.ErrNotFound kind if there is no such account.
+	UpdateUser(ctx context.Context, user models.User) error
+
+	// DeleteUser removes an account together with the tasks, tags and projects it owns.
+	// It returns any error encountered, which is of the domain.ErrNotFound kind if there is no such account.
+	DeleteUser(ctx context.Context, id int64) error
+
+	// AdoptOrphans gives the records made before there were accounts to the user with the ID.
+	// It returns any error encountered.
+	AdoptOrphans(ctx context.Context, userID int64) error
+
+	// InTx runs fn in a transaction. Storage calls made with the context passed to fn take part in it.
+	// The transaction is committed if fn returns nil and rolled back otherwise.
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Clock provides the current time.
+// It is replaced in tests to make the creation times of accounts deterministic.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// UserService manages the accounts tasks belong to.
+type UserService struct {
+	// storage is the instance of account storage.
+	storage UserStorage
+	// clock is the source of the current time.
+	clock Clock
+	// hashCost is the bcrypt cost passwords are hashed with.
+	hashCost int
+}
+
+// Option configures a UserService.
+type Option func(*UserService)
+
+// WithClock sets the source of the current time. The system clock is used by default.
+func WithClock(clock Clock) Option {
+	return func(service *UserService) {
+		service.clock = clock
+	}
+}
+
+// WithHashCost sets the bcrypt cost passwords are hashed with. bcrypt.DefaultCost is used by default.
+func WithHashCost(cost int) Option {
+	return func(service *UserService) {
+		service.hashCost = cost
+	}
+}
+
+// New creates a new UserService with the given UserStorage.
+func New(storage UserStorage, opts ...Option) UserService {
+	service := UserService{storage: storage, clock: systemClock{}, hashCost: bcrypt.DefaultCost}
+	for _, opt := range opts {
+		opt(&service)
+	}
+	return service
+}
+
+// Register creates an account with the name and password. Only the bcrypt hash of the password is stored.
+// The reminders of the user's tasks are due in the IANA time zone, or in the default one if it is empty.
+// It returns ErrNameTaken if another account has the name and ErrNoAdmin if there are no accounts yet,
+// since only Bootstrap may create the first one.
+// It returns the created account and any error encountered.
+func (service UserService) Register(ctx context.Context, name, password, timezone string, admin bool) (models.User, error) {
+	user, err := service.newUser(name, password, timezone, admin)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	err = service.storage.InTx(ctx, func(ctx context.Context) error {
+		_, err := service.storage.ReadUserByName(ctx, name)
+		if err == nil {
+			return fmt.Errorf("%w: %s", ErrNameTaken, name)
+		}
+		if !errors.Is(err, domain.ErrNotFound) {
+			return err
+		}
+
+		existing, err := service.storage.ReadUsers(ctx)
+		if err != nil {
+			return err
+		}
+		if len(existing) == 0 {
+			return ErrNoAdmin
+		}
+
+		user.ID, err = service.storage.CreateUser(ctx, user)
+		return err
+	})
+	if err != nil {
+		return models.User{}, err
+	}
+	return user, nil
+}
+
+// Bootstrap registers the admin account BootstrapName with the password unless there already are accounts.
+// It lets an installation which used to be protected by a single password keep signing in with it.
+// The account adopts the tasks, tags and projects made before there were accounts.
+// It returns whether the account was created and any error encountered.
+func (service UserService) Bootstrap(ctx context.Context, password string) (bool, error) {
+	var created bool
+	err := service.storage.InTx(ctx, func(ctx context.Context) error {
+		existing, err := service.storage.ReadUsers(ctx)
+		if err != nil || len(existing) > 0 {
+			return err
+		}
+
+		user, err := service.newUser(BootstrapName, password, "", true)
+		if err != nil {
+			return err
+		}
+		if user.ID, err = service.storage.CreateUser(ctx, user); err != nil {
+			return err
+		}
+		created = true
+		return service.storage.AdoptOrphans(ctx, user.ID)
+	})
+	if err != nil {
+		return false, err
+	}
+	return created, nil
+}
+
+// newUser prepares an account for the storage, hashing the password.
+func (service UserService) newUser(name, password, timezone string, admin bool) (models.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), service.hashCost)
+	if err != nil {
+		return models.User{}, fmt.Errorf("cannot hash password: %w", err)
+	}
+	return models.User{
+		Name:         name,
+		Admin:        admin,
+		Timezone:     timezone,
+		PasswordHash: string(hash),
+		CreatedAt:    service.clock.Now().UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// SignIn checks the password of the account with the name.
+// It returns ErrWrongCredentials if there is no such account or the password does not match.
+// It returns the account and any error encountered.
+func (service UserService) SignIn(ctx context.Context, name, password string) (models.User, error) {
+	user, err := service.storage.ReadUserByName(ctx, name)
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.User{}, ErrWrongCredentials
+	}
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return models.User{}, ErrWrongCredentials
+	}
+	return user, nil
+}
+
+// User retrieves an account by its ID. It returns ErrUserNotFound if there is no account with the ID.
+func (service UserService) User(ctx context.Context, id int64) (models.User, error) {
+	user, err := service.storage.ReadUser(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.User{}, fmt.Errorf("%w: %d", ErrUserNotFound, id)
+	}
+	return user, err
+}
+
+// Users retrieves all accounts in alphabetical order.
+func (service UserService) Users(ctx context.Context) ([]models.User, error) {
+	return service.storage.ReadUsers(ctx)
+}
+
+// Update sets the admin rights of an account and, unless they are empty, replaces its password and its time zone.
+// It returns ErrUserNotFound if there is no account with the ID and ErrLastAdmin if the only admin would lose the rights.
+func (service UserService) Update(ctx context.Context, id int64, password, timezone string, admin bool) error {
+	var hash []byte
+	if password != "" {
+		var err error
+		if hash, err = bcrypt.GenerateFromPassword([]byte(password), service.hashCost); err != nil {
+			return fmt.Errorf("cannot hash password: %w", err)
+		}
+	}
+
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		user, err := service.User(ctx, id)
+		if err != nil {
+			return err
+		}
+		if user.Admin && !admin {
+			if err := service.checkOtherAdmins(ctx, id); err != nil {
+				return err
+			}
+		}
+
+		user.Admin = admin
+		if hash != nil {
+			user.PasswordHash = string(hash)
+		}
+		if timezone != "" {
+			user.Timezone = timezone
+		}
+		return service.storage.UpdateUser(ctx, user)
+	})
+}
+
+// Delete removes an account together with the tasks, tags and projects it owns.
+// It returns ErrUserNotFound if there is no account with the ID and ErrLastAdmin if it is the only admin.
+func (service UserService) Delete(ctx context.Context, id int64) error {
+	return service.storage.InTx(ctx, func(ctx context.Context) error {
+		user, err := service.User(ctx, id)
+		if err != nil {
+			return err
+		}
+		if user.Admin {
+			if err := service.checkOtherAdmins(ctx, id); err != nil {
+				return err
+			}
+		}
+		return service.storage.DeleteUser(ctx, id)
+	})
+}
+
+// checkOtherAdmins returns ErrLastAdmin unless an account other than the one with the ID is an admin.
+func (service UserService) checkOtherAdmins(ctx context.Context, id int64) error {
+	existing, err := service.storage.ReadUsers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, user := range existing {
+		if user.Admin && user.ID != id {
+			return nil
+		}
+	}
+	return ErrLastAdmin
+}
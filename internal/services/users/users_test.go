@@ -0,0 +1,348 @@
+package users_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/10Narratives/task-tracker/internal/services/users"
+	"github.com/10Narratives/task-tracker/internal/services/users/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserService_Register(t *testing.T) {
+	t.Parallel()
+
+	alice := models.User{ID: 3, Name: "alice", Admin: true}
+
+	tests := []struct {
+		name      string
+		mockSetup func(m *mocks.UserStorage)
+		admin     bool
+		wantUser  models.User
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "first user is refused until the admin is bootstrapped",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUserByName", mock.Anything, "bob").Return(models.User{}, domain.New(domain.ErrNotFound, `user "bob" not found`))
+				m.On("ReadUsers", mock.Anything).Return([]models.User{}, nil)
+			},
+			wantUser: models.User{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, users.ErrNoAdmin, i...)
+				require.ErrorIs(tt, err, domain.ErrConflict, i...)
+			},
+		},
+		{
+			name: "later user keeps the requested rights",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUserByName", mock.Anything, "bob").Return(models.User{}, domain.New(domain.ErrNotFound, `user "bob" not found`))
+				m.On("ReadUsers", mock.Anything).Return([]models.User{alice}, nil)
+				m.On("CreateUser", mock.Anything, mock.MatchedBy(func(u models.User) bool { return !u.Admin })).Return(int64(4), nil)
+			},
+			wantUser: models.User{ID: 4, Name: "bob", Timezone: "Europe/Berlin", CreatedAt: "2025-04-10T10:30:00Z"},
+			wantErr:  require.NoError,
+		},
+		{
+			name: "name taken",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUserByName", mock.Anything, "bob").Return(models.User{ID: 4, Name: "bob"}, nil)
+			},
+			wantUser: models.User{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, users.ErrNameTaken, i...)
+				require.ErrorIs(tt, err, domain.ErrConflict, i...)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUserByName", mock.Anything, "bob").Return(models.User{}, errors.New("database error"))
+			},
+			wantUser: models.User{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "database error", i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewUserStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			service := newService(storage)
+			user, err := service.Register(context.Background(), "bob", "correct horse", "Europe/Berlin", tc.admin)
+			tc.wantErr(t, err)
+
+			if tc.wantUser.ID != 0 {
+				require.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("correct horse")))
+				user.PasswordHash = ""
+			}
+			assert.Equal(t, tc.wantUser, user)
+		})
+	}
+}
+
+func TestUserService_Bootstrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("owner created", func(t *testing.T) {
+		t.Parallel()
+
+		storage := mocks.NewUserStorage(t)
+		passThroughTx(storage)
+		storage.On("ReadUsers", mock.Anything).Return([]models.User{}, nil)
+		storage.On("CreateUser", mock.Anything, mock.MatchedBy(func(u models.User) bool {
+			return u.Name == users.BootstrapName && u.Admin
+		})).Return(int64(1), nil)
+		storage.On("AdoptOrphans", mock.Anything, int64(1)).Return(nil)
+
+		created, err := newService(storage).Bootstrap(context.Background(), "correct horse")
+		require.NoError(t, err)
+		assert.True(t, created)
+	})
+
+	t.Run("accounts exist", func(t *testing.T) {
+		t.Parallel()
+
+		storage := mocks.NewUserStorage(t)
+		passThroughTx(storage)
+		storage.On("ReadUsers", mock.Anything).Return([]models.User{{ID: 3, Name: "alice", Admin: true}}, nil)
+
+		created, err := newService(storage).Bootstrap(context.Background(), "correct horse")
+		require.NoError(t, err)
+		assert.False(t, created)
+	})
+}
+
+func TestUserService_SignIn(t *testing.T) {
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	alice := models.User{ID: 3, Name: "alice", PasswordHash: string(hash)}
+
+	tests := []struct {
+		name      string
+		mockSetup func(m *mocks.UserStorage)
+		password  string
+		wantUser  models.User
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "correct password",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUserByName", mock.Anything, "alice").Return(alice, nil)
+			},
+			password: "correct horse",
+			wantUser: alice,
+			wantErr:  require.NoError,
+		},
+		{
+			name: "wrong password",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUserByName", mock.Anything, "alice").Return(alice, nil)
+			},
+			password: "battery staple",
+			wantUser: models.User{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, users.ErrWrongCredentials, i...)
+			},
+		},
+		{
+			name: "unknown user",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUserByName", mock.Anything, "alice").Return(models.User{}, domain.New(domain.ErrNotFound, `user "alice" not found`))
+			},
+			password: "correct horse",
+			wantUser: models.User{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, users.ErrWrongCredentials, i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewUserStorage(t)
+			tc.mockSetup(storage)
+
+			user, err := newService(storage).SignIn(context.Background(), "alice", tc.password)
+			tc.wantErr(t, err)
+			assert.Equal(t, tc.wantUser, user)
+		})
+	}
+}
+
+func TestUserService_Update(t *testing.T) {
+	t.Parallel()
+
+	alice := models.User{ID: 3, Name: "alice", Admin: true, PasswordHash: "$2a$04$alice"}
+	bob := models.User{ID: 4, Name: "bob", PasswordHash: "$2a$04$bob"}
+
+	tests := []struct {
+		name      string
+		mockSetup func(m *mocks.UserStorage)
+		id        int64
+		password  string
+		timezone  string
+		admin     bool
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "admin rights granted, password kept",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUser", mock.Anything, int64(4)).Return(bob, nil)
+				m.On("UpdateUser", mock.Anything, models.User{ID: 4, Name: "bob", Admin: true, PasswordHash: "$2a$04$bob"}).Return(nil)
+			},
+			id:      4,
+			admin:   true,
+			wantErr: require.NoError,
+		},
+		{
+			name: "password replaced",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUser", mock.Anything, int64(4)).Return(bob, nil)
+				m.On("UpdateUser", mock.Anything, mock.MatchedBy(func(u models.User) bool {
+					return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte("battery staple")) == nil
+				})).Return(nil)
+			},
+			id:       4,
+			password: "battery staple",
+			wantErr:  require.NoError,
+		},
+		{
+			name: "time zone replaced",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUser", mock.Anything, int64(4)).Return(bob, nil)
+				m.On("UpdateUser", mock.Anything, models.User{ID: 4, Name: "bob", Timezone: "Europe/Berlin", PasswordHash: "$2a$04$bob"}).Return(nil)
+			},
+			id:       4,
+			timezone: "Europe/Berlin",
+			wantErr:  require.NoError,
+		},
+		{
+			name: "last admin keeps the rights",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUser", mock.Anything, int64(3)).Return(alice, nil)
+				m.On("ReadUsers", mock.Anything).Return([]models.User{alice, bob}, nil)
+			},
+			id: 3,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, users.ErrLastAdmin, i...)
+			},
+		},
+		{
+			name: "user not found",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUser", mock.Anything, int64(5)).Return(models.User{}, domain.New(domain.ErrNotFound, "user 5 not found"))
+			},
+			id: 5,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, users.ErrUserNotFound, i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewUserStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			err := newService(storage).Update(context.Background(), tc.id, tc.password, tc.timezone, tc.admin)
+			tc.wantErr(t, err)
+		})
+	}
+}
+
+func TestUserService_Delete(t *testing.T) {
+	t.Parallel()
+
+	alice := models.User{ID: 3, Name: "alice", Admin: true}
+	carol := models.User{ID: 5, Name: "carol", Admin: true}
+
+	tests := []struct {
+		name      string
+		mockSetup func(m *mocks.UserStorage)
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "admin deleted while another admin remains",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUser", mock.Anything, int64(3)).Return(alice, nil)
+				m.On("ReadUsers", mock.Anything).Return([]models.User{alice, carol}, nil)
+				m.On("DeleteUser", mock.Anything, int64(3)).Return(nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "last admin kept",
+			mockSetup: func(m *mocks.UserStorage) {
+				m.On("ReadUser", mock.Anything, int64(3)).Return(alice, nil)
+				m.On("ReadUsers", mock.Anything).Return([]models.User{alice}, nil)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, users.ErrLastAdmin, i...)
+				require.ErrorIs(tt, err, domain.ErrConflict, i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewUserStorage(t)
+			passThroughTx(storage)
+			tc.mockSetup(storage)
+
+			err := newService(storage).Delete(context.Background(), 3)
+			tc.wantErr(t, err)
+		})
+	}
+}
+
+// newService creates a UserService with a fixed clock and the cheapest bcrypt cost to keep the tests fast.
+func newService(storage users.UserStorage) users.UserService {
+	return users.New(storage,
+		users.WithClock(fixedClock(time.Date(2025, 4, 10, 10, 30, 0, 0, time.UTC))),
+		users.WithHashCost(bcrypt.MinCost),
+	)
+}
+
+// passThroughTx makes the storage mock run transactions by calling the function directly.
+func passThroughTx(m *mocks.UserStorage) {
+	m.
+		On("InTx", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+}
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
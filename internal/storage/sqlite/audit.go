@@ -0,0 +1,141 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+// CreateAuditEntry appends a change of a task to the audit_log table.
+// The snapshots of the task before and after the change, if any, are kept as JSON.
+//
+// Returns:
+// - int64: ID of the created record.
+// - error: Wrapped error if a snapshot can not be encoded or the insert fails.
+func (s TaskStorage) CreateAuditEntry(ctx context.Context, entry models.AuditEntry) (int64, error) {
+	before, err := encodeSnapshot(entry.Before)
+	if err != nil {
+		return 0, err
+	}
+	after, err := encodeSnapshot(entry.After)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `INSERT INTO audit_log (actor, operation, task_id, before, after, created_at) VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := s.conn(ctx).ExecContext(ctx, query, entry.Actor, entry.Operation, entry.TaskID, before, after, entry.CreatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("cannot insert audit entry in database: %w", err)
+	}
+
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("cannot take last insert id: %w", err)
+	}
+
+	return lastID, nil
+}
+
+// ReadAuditEntries retrieves a page of the audit entries matching the filter, most recent first.
+// The page holds at most s.Limit entries.
+//
+// Returns:
+// - []models.AuditEntry: The matching entries, empty if there are none.
+// - error: Wrapped error if the query fails or a snapshot can not be decoded.
+func (s TaskStorage) ReadAuditEntries(ctx context.Context, filter models.AuditFilter) ([]models.AuditEntry, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	if filter.Actor != "" {
+		conditions = append(conditions, "actor = ?")
+		args = append(args, filter.Actor)
+	}
+	if filter.Operation != "" {
+		conditions = append(conditions, "operation = ?")
+		args = append(args, filter.Operation)
+	}
+	if filter.TaskID != 0 {
+		conditions = append(conditions, "task_id = ?")
+		args = append(args, filter.TaskID)
+	}
+	if filter.From != "" {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, filter.From)
+	}
+	if filter.To != "" {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, filter.To)
+	}
+
+	limit := int(s.Limit)
+	if filter.Limit > 0 && filter.Limit < limit {
+		limit = filter.Limit
+	}
+
+	query := `SELECT id, actor, operation, task_id, before, after, created_at FROM audit_log`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY id DESC LIMIT ? OFFSET ?`
+	args = append(args, limit, filter.Offset)
+
+	rows, err := s.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return make([]models.AuditEntry, 0), fmt.Errorf("cannot execute query: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]models.AuditEntry, 0)
+	for rows.Next() {
+		var (
+			entry         models.AuditEntry
+			before, after string
+		)
+		if err := rows.Scan(&entry.ID, &entry.Actor, &entry.Operation, &entry.TaskID, &before, &after, &entry.CreatedAt); err != nil {
+			return make([]models.AuditEntry, 0), fmt.Errorf("cannot read row: %w", err)
+		}
+		if entry.Before, err = decodeSnapshot(before); err != nil {
+			return make([]models.AuditEntry, 0), err
+		}
+		if entry.After, err = decodeSnapshot(after); err != nil {
+			return make([]models.AuditEntry, 0), err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return make([]models.AuditEntry, 0), fmt.Errorf("cannot read audit entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// encodeSnapshot and decodeSnapshot convert a task snapshot to and from the JSON kept in the database.
+// A missing snapshot is kept as an empty string.
+func encodeSnapshot(task *models.Task) (string, error) {
+	if task == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(task)
+	if err != nil {
+		return "", fmt.Errorf("cannot encode task snapshot: %w", err)
+	}
+	return string(data), nil
+}
+
+func decodeSnapshot(snapshot string) (*models.Task, error) {
+	if snapshot == "" {
+		return nil, nil
+	}
+
+	task := new(models.Task)
+	if err := json.Unmarshal([]byte(snapshot), task); err != nil {
+		return nil, fmt.Errorf("cannot decode task snapshot: %w", err)
+	}
+	return task, nil
+}
@@ -0,0 +1,201 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_CreateAuditEntry(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("INSERT INTO audit_log (actor, operation, task_id, before, after, created_at) VALUES (?, ?, ?, ?, ?, ?)")
+
+	tests := []struct {
+		name    string
+		entry   models.AuditEntry
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantID  int64
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "entry with both snapshots",
+			entry: models.AuditEntry{
+				Actor:     "owner",
+				Operation: models.OperationUpdate,
+				TaskID:    7,
+				Before:    &models.Task{ID: 7, Date: "20250410", Title: "Title", Version: 1},
+				After:     &models.Task{ID: 7, Date: "20250411", Title: "Title", Version: 2},
+				CreatedAt: "2025-04-10T10:30:00Z",
+			},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).
+					WithArgs("owner", "update", 7,
+						`{"id":7,"date":"20250410","title":"Title","comment":"","repeat":"","anchor":"","occurrence":0,"priority":0,"status":"","version":1}`,
+						`{"id":7,"date":"20250411","title":"Title","comment":"","repeat":"","anchor":"","occurrence":0,"priority":0,"status":"","version":2}`,
+						"2025-04-10T10:30:00Z").
+					WillReturnResult(sqlmock.NewResult(5, 1))
+			},
+			wantID:  5,
+			wantErr: require.NoError,
+		},
+		{
+			name:  "registration",
+			entry: models.AuditEntry{Actor: "owner", Operation: models.OperationRegister, TaskID: 7, After: &models.Task{ID: 7}, CreatedAt: "2025-04-10T10:30:00Z"},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).
+					WithArgs("owner", "register", 7, "", `{"id":7,"date":"","title":"","comment":"","repeat":"","anchor":"","occurrence":0,"priority":0,"status":""}`, "2025-04-10T10:30:00Z").
+					WillReturnResult(sqlmock.NewResult(6, 1))
+			},
+			wantID:  6,
+			wantErr: require.NoError,
+		},
+		{
+			name:  "database error",
+			entry: models.AuditEntry{Actor: "owner", Operation: models.OperationDelete, TaskID: 7, CreatedAt: "2025-04-10T10:30:00Z"},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).
+					WithArgs("owner", "delete", 7, "", "", "2025-04-10T10:30:00Z").
+					WillReturnError(errors.New("database error"))
+			},
+			wantID: 0,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot insert audit entry in database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			id, err := storage.CreateAuditEntry(context.Background(), tt.entry)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantID, id)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_ReadAuditEntries(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "actor", "operation", "task_id", "before", "after", "created_at"}
+	selectAudit := "SELECT id, actor, operation, task_id, before, after, created_at FROM audit_log"
+
+	tests := []struct {
+		name        string
+		filter      models.AuditFilter
+		mocks       func(dbMock sqlmock.Sqlmock)
+		wantEntries []models.AuditEntry
+		wantErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:   "whole log - storage limit",
+			filter: models.AuditFilter{},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(2, "owner", "delete", 7, `{"id":7,"date":"20250410","title":"Title"}`, "", "2025-04-10T10:31:00Z").
+					AddRow(1, "owner", "register", 7, "", `{"id":7,"date":"20250410","title":"Title"}`, "2025-04-10T10:30:00Z")
+				dbMock.ExpectQuery(regexp.QuoteMeta(selectAudit+" ORDER BY id DESC LIMIT ? OFFSET ?")).
+					WithArgs(3, 0).
+					WillReturnRows(rows)
+			},
+			wantEntries: []models.AuditEntry{
+				{ID: 2, Actor: "owner", Operation: "delete", TaskID: 7, Before: &models.Task{ID: 7, Date: "20250410", Title: "Title"}, CreatedAt: "2025-04-10T10:31:00Z"},
+				{ID: 1, Actor: "owner", Operation: "register", TaskID: 7, After: &models.Task{ID: 7, Date: "20250410", Title: "Title"}, CreatedAt: "2025-04-10T10:30:00Z"},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "filtered page",
+			filter: models.AuditFilter{
+				Actor:     "owner",
+				Operation: "update",
+				TaskID:    7,
+				From:      "2025-04-10T00:00:00Z",
+				To:        "2025-04-11T00:00:00Z",
+				Limit:     2,
+				Offset:    4,
+			},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(regexp.QuoteMeta(selectAudit+" WHERE actor = ? AND operation = ? AND task_id = ? AND created_at >= ? AND created_at < ? ORDER BY id DESC LIMIT ? OFFSET ?")).
+					WithArgs("owner", "update", 7, "2025-04-10T00:00:00Z", "2025-04-11T00:00:00Z", 2, 4).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			wantEntries: []models.AuditEntry{},
+			wantErr:     require.NoError,
+		},
+		{
+			name:   "page larger than the storage limit",
+			filter: models.AuditFilter{Limit: 50},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(regexp.QuoteMeta(selectAudit+" ORDER BY id DESC LIMIT ? OFFSET ?")).
+					WithArgs(3, 0).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			wantEntries: []models.AuditEntry{},
+			wantErr:     require.NoError,
+		},
+		{
+			name:   "broken snapshot",
+			filter: models.AuditFilter{},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, "owner", "register", 7, "", `{"id":`, "2025-04-10T10:30:00Z")
+				dbMock.ExpectQuery(regexp.QuoteMeta(selectAudit+" ORDER BY id DESC LIMIT ? OFFSET ?")).
+					WithArgs(3, 0).
+					WillReturnRows(rows)
+			},
+			wantEntries: []models.AuditEntry{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot decode task snapshot: unexpected end of JSON input", i...)
+			},
+		},
+		{
+			name:   "database error",
+			filter: models.AuditFilter{},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(regexp.QuoteMeta(selectAudit+" ORDER BY id DESC LIMIT ? OFFSET ?")).
+					WithArgs(3, 0).
+					WillReturnError(errors.New("database error"))
+			},
+			wantEntries: []models.AuditEntry{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			entries, err := storage.ReadAuditEntries(context.Background(), tt.filter)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantEntries, entries)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+// CreateCompletion records a completed or skipped task occurrence in the completions table.
+// The completion belongs to the owner of the task.
+//
+// Returns:
+// - int64: ID of the created record.
+// - error: Wrapped error if the insert fails.
+func (s TaskStorage) CreateCompletion(ctx context.Context, c models.Completion) (int64, error) {
+	query := `INSERT INTO completions (task_id, title, date, completed_at, skipped, owner_id) VALUES (?, ?, ?, ?, ?, ` + taskOwner + `)`
+	result, err := s.conn(ctx).ExecContext(ctx, query, c.TaskID, c.Title, c.Date, c.CompletedAt, c.Skipped, c.TaskID)
+	if err != nil {
+		return 0, fmt.Errorf("cannot insert completion in database: %w", err)
+	}
+
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("cannot take last insert id: %w", err)
+	}
+
+	return lastID, nil
+}
+
+// ReadCompletions retrieves the completions matching the filter, ordered by completion time.
+//
+// Returns:
+// - []models.Completion: The matching completions, empty if there are none.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) ReadCompletions(ctx context.Context, filter models.CompletionFilter) ([]models.Completion, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	if filter.TaskID != 0 {
+		conditions = append(conditions, "task_id = ?")
+		args = append(args, filter.TaskID)
+	}
+	if filter.From != "" {
+		conditions = append(conditions, "completed_at >= ?")
+		args = append(args, filter.From)
+	}
+	if filter.To != "" {
+		conditions = append(conditions, "completed_at < ?")
+		args = append(args, filter.To)
+	}
+	if owner, ok := ownerID(ctx); ok {
+		conditions = append(conditions, "owner_id = ?")
+		args = append(args, owner)
+	}
+
+	query := `SELECT id, task_id, title, date, completed_at, skipped FROM completions`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY completed_at, id`
+
+	rows, err := s.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return make([]models.Completion, 0), fmt.Errorf("cannot execute query: %w", err)
+	}
+	defer rows.Close()
+
+	completions := make([]models.Completion, 0)
+	for rows.Next() {
+		var c models.Completion
+		if err := rows.Scan(&c.ID, &c.TaskID, &c.Title, &c.Date, &c.CompletedAt, &c.Skipped); err != nil {
+			return make([]models.Completion, 0), fmt.Errorf("cannot read row: %w", err)
+		}
+		completions = append(completions, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return make([]models.Completion, 0), fmt.Errorf("cannot read completions: %w", err)
+	}
+
+	return completions, nil
+}
+
+// DeleteCompletion removes a recorded completion by its ID.
+//
+// Returns:
+// - error: Wrapped error if the deletion fails.
+func (s TaskStorage) DeleteCompletion(ctx context.Context, id int64) error {
+	owner, ownerArgs := owned(ctx, "owner_id")
+	query := `DELETE FROM completions WHERE id = ?` + owner
+	_, err := s.conn(ctx).ExecContext(ctx, query, append([]any{id}, ownerArgs...)...)
+	if err != nil {
+		return fmt.Errorf("failed to delete completion: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_CreateCompletion(t *testing.T) {
+	t.Parallel()
+
+	completion := models.Completion{TaskID: 7, Title: "Test title", Date: "20250410", CompletedAt: "2025-04-10T10:30:00Z"}
+	query := regexp.QuoteMeta("INSERT INTO completions (task_id, title, date, completed_at, skipped, owner_id) VALUES (?, ?, ?, ?, ?, COALESCE((SELECT owner_id FROM scheduler WHERE id = ?), 0))")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantID  int64
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "successful creation",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).
+					WithArgs(completion.TaskID, completion.Title, completion.Date, completion.CompletedAt, completion.Skipped, completion.TaskID).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+			},
+			wantID:  3,
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).
+					WithArgs(completion.TaskID, completion.Title, completion.Date, completion.CompletedAt, completion.Skipped, completion.TaskID).
+					WillReturnError(errors.New("database error"))
+			},
+			wantID: 0,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot insert completion in database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			id, err := storage.CreateCompletion(context.Background(), completion)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantID, id)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_ReadCompletions(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "task_id", "title", "date", "completed_at", "skipped"}
+
+	tests := []struct {
+		name            string
+		filter          models.CompletionFilter
+		mocks           func(dbMock sqlmock.Sqlmock)
+		wantCompletions []models.Completion
+		wantErr         require.ErrorAssertionFunc
+	}{
+		{
+			name:   "all completions",
+			filter: models.CompletionFilter{},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, 7, "Test title", "20250410", "2025-04-10T10:30:00Z", false).
+					AddRow(2, 8, "Other title", "20250411", "2025-04-11T08:00:00Z", true)
+				query := regexp.QuoteMeta("SELECT id, task_id, title, date, completed_at, skipped FROM completions ORDER BY completed_at, id")
+				dbMock.ExpectQuery(query).WillReturnRows(rows)
+			},
+			wantCompletions: []models.Completion{
+				{ID: 1, TaskID: 7, Title: "Test title", Date: "20250410", CompletedAt: "2025-04-10T10:30:00Z"},
+				{ID: 2, TaskID: 8, Title: "Other title", Date: "20250411", CompletedAt: "2025-04-11T08:00:00Z", Skipped: true},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:   "completions of a task",
+			filter: models.CompletionFilter{TaskID: 7},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, 7, "Test title", "20250410", "2025-04-10T10:30:00Z", false)
+				query := regexp.QuoteMeta("SELECT id, task_id, title, date, completed_at, skipped FROM completions WHERE task_id = ? ORDER BY completed_at, id")
+				dbMock.ExpectQuery(query).WithArgs(7).WillReturnRows(rows)
+			},
+			wantCompletions: []models.Completion{
+				{ID: 1, TaskID: 7, Title: "Test title", Date: "20250410", CompletedAt: "2025-04-10T10:30:00Z"},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:   "completions in a time range",
+			filter: models.CompletionFilter{From: "2025-04-07T00:00:00Z", To: "2025-04-14T00:00:00Z"},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns)
+				query := regexp.QuoteMeta("SELECT id, task_id, title, date, completed_at, skipped FROM completions WHERE completed_at >= ? AND completed_at < ? ORDER BY completed_at, id")
+				dbMock.ExpectQuery(query).WithArgs("2025-04-07T00:00:00Z", "2025-04-14T00:00:00Z").WillReturnRows(rows)
+			},
+			wantCompletions: []models.Completion{},
+			wantErr:         require.NoError,
+		},
+		{
+			name:   "database error",
+			filter: models.CompletionFilter{TaskID: 7},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				query := regexp.QuoteMeta("SELECT id, task_id, title, date, completed_at, skipped FROM completions WHERE task_id = ? ORDER BY completed_at, id")
+				dbMock.ExpectQuery(query).WithArgs(7).WillReturnError(errors.New("database error"))
+			},
+			wantCompletions: []models.Completion{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			completions, err := storage.ReadCompletions(context.Background(), tt.filter)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantCompletions, completions)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_DeleteCompletion(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("DELETE FROM completions WHERE id = ?")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "successful deletion",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(3).WillReturnError(errors.New("database error"))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to delete completion: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.DeleteCompletion(context.Background(), 3)
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
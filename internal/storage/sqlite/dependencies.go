@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// AddDependency makes a task wait for its blocker from now on. Adding an existing dependency changes nothing.
+//
+// Returns:
+// - error: Wrapped error if the insert fails.
+func (s TaskStorage) AddDependency(ctx context.Context, taskID, blockerID int64) error {
+	query := `INSERT OR IGNORE INTO dependencies (task_id, blocker_id, created_at) VALUES (?, ?, ` + nowUTC + `)`
+	if _, err := s.conn(ctx).ExecContext(ctx, query, taskID, blockerID); err != nil {
+		return fmt.Errorf("failed to add dependency: %w", err)
+	}
+	return nil
+}
+
+// RemoveDependency stops a task from waiting for its blocker.
+//
+// Returns:
+// - error: An error of the domain.ErrNotFound kind if the task does not wait for the blocker,
+// or a wrapped error if the deletion fails.
+func (s TaskStorage) RemoveDependency(ctx context.Context, taskID, blockerID int64) error {
+	query := `DELETE FROM dependencies WHERE task_id = ? AND blocker_id = ?`
+	args := []any{taskID, blockerID}
+	if owner, ok := ownerID(ctx); ok {
+		query += ` AND task_id IN (SELECT id FROM scheduler WHERE owner_id = ?)`
+		args = append(args, owner)
+	}
+	result, err := s.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to remove dependency: %w", err)
+	}
+	return expectAffected(result, domain.New(domain.ErrNotFound, fmt.Sprintf("task %d does not depend on task %d", taskID, blockerID)))
+}
+
+// DependsOn reports whether a task waits for the blocker, directly or through other tasks.
+// Dependencies of trashed tasks are followed too, since they come back when the tasks are restored.
+//
+// Returns:
+// - bool: Whether the blocker can be reached from the task.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) DependsOn(ctx context.Context, taskID, blockerID int64) (bool, error) {
+	query := `
+		WITH RECURSIVE blockers(id) AS (
+			SELECT blocker_id FROM dependencies WHERE task_id = ?
+			UNION
+			SELECT dependencies.blocker_id FROM dependencies JOIN blockers ON dependencies.task_id = blockers.id
+		)
+		SELECT EXISTS (SELECT 1 FROM blockers WHERE id = ?)
+	`
+
+	var depends bool
+	if err := s.conn(ctx).QueryRowContext(ctx, query, taskID, blockerID).Scan(&depends); err != nil {
+		return false, fmt.Errorf("cannot read dependencies from database: %w", err)
+	}
+	return depends, nil
+}
+
+// OpenBlockers retrieves the IDs of the blockers of a task which are outside the trash and not done yet.
+// A recurring blocker goes back to the todo status when it is completed, so one which has been completed,
+// not skipped, since the dependency was added is not open either.
+//
+// Returns:
+// - []int64: IDs of the open blockers in ascending order.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) OpenBlockers(ctx context.Context, taskID int64) ([]int64, error) {
+	query := `
+		SELECT dependencies.blocker_id FROM dependencies
+		JOIN scheduler ON scheduler.id = dependencies.blocker_id
+		WHERE dependencies.task_id = ? AND scheduler.deleted_at IS NULL AND scheduler.status != ?
+			AND NOT EXISTS (
+				SELECT 1 FROM completions
+				WHERE completions.task_id = dependencies.blocker_id AND completions.skipped = 0
+					AND completions.completed_at >= dependencies.created_at
+			)
+		ORDER BY dependencies.blocker_id
+	`
+	rows, err := s.conn(ctx).QueryContext(ctx, query, taskID, models.StatusDone)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var blockers []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("cannot read row: %w", err)
+		}
+		blockers = append(blockers, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read blockers: %w", err)
+	}
+
+	return blockers, nil
+}
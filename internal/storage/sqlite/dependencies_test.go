@@ -0,0 +1,203 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_AddDependency(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("INSERT OR IGNORE INTO dependencies (task_id, blocker_id, created_at) VALUES (?, ?, strftime('%Y-%m-%dT%H:%M:%SZ', 'now'))")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "dependency added",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(7, 4).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(7, 4).WillReturnError(errors.New("database error"))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to add dependency: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.AddDependency(context.Background(), 7, 4)
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_RemoveDependency(t *testing.T) {
+	t.Parallel()
+
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM dependencies WHERE task_id = ? AND blocker_id = ?")).
+		WithArgs(7, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = sqlite.New(db, 3).RemoveDependency(context.Background(), 7, 4)
+	require.NoError(t, err)
+
+	dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM dependencies WHERE task_id = ? AND blocker_id = ?")).
+		WithArgs(7, 4).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = sqlite.New(db, 3).RemoveDependency(context.Background(), 7, 4)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	require.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestTaskStorage_DependsOn(t *testing.T) {
+	t.Parallel()
+
+	query := `WITH RECURSIVE blockers\(id\) AS .+ SELECT EXISTS \(SELECT 1 FROM blockers WHERE id = \?\)`
+
+	tests := []struct {
+		name        string
+		mocks       func(dbMock sqlmock.Sqlmock)
+		wantDepends bool
+		wantErr     require.ErrorAssertionFunc
+	}{
+		{
+			name: "blocker is reachable",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(4, 7).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			wantDepends: true,
+			wantErr:     require.NoError,
+		},
+		{
+			name: "blocker is not reachable",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(4, 7).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+			wantDepends: false,
+			wantErr:     require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(4, 7).WillReturnError(errors.New("database error"))
+			},
+			wantDepends: false,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot read dependencies from database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			depends, err := storage.DependsOn(context.Background(), 4, 7)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantDepends, depends)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_OpenBlockers(t *testing.T) {
+	t.Parallel()
+
+	query := `SELECT dependencies\.blocker_id FROM dependencies\s+JOIN scheduler ON scheduler\.id = dependencies\.blocker_id\s+` +
+		`WHERE dependencies\.task_id = \? AND scheduler\.deleted_at IS NULL AND scheduler\.status != \?\s+` +
+		`AND NOT EXISTS \(\s+SELECT 1 FROM completions\s+` +
+		`WHERE completions\.task_id = dependencies\.blocker_id AND completions\.skipped = 0\s+` +
+		`AND completions\.completed_at >= dependencies\.created_at\s+\)`
+
+	tests := []struct {
+		name         string
+		mocks        func(dbMock sqlmock.Sqlmock)
+		wantBlockers []int64
+		wantErr      require.ErrorAssertionFunc
+	}{
+		{
+			name: "open blockers",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(7, "done").WillReturnRows(sqlmock.NewRows([]string{"blocker_id"}).AddRow(2).AddRow(4))
+			},
+			wantBlockers: []int64{2, 4},
+			wantErr:      require.NoError,
+		},
+		{
+			name: "no open blockers",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(7, "done").WillReturnRows(sqlmock.NewRows([]string{"blocker_id"}))
+			},
+			wantBlockers: nil,
+			wantErr:      require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(7, "done").WillReturnError(errors.New("database error"))
+			},
+			wantBlockers: nil,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			blockers, err := storage.OpenBlockers(context.Background(), 7)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantBlockers, blockers)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+// CreateOperation records a reversible operation in the operations table.
+// The snapshot of the task, if any, is kept as JSON and the operation belongs to the owner of the task.
+//
+// Returns:
+// - int64: ID of the created record.
+// - error: Wrapped error if the snapshot can not be encoded or the insert fails.
+func (s TaskStorage) CreateOperation(ctx context.Context, op models.Operation) (int64, error) {
+	snapshot, err := encodeSnapshot(op.Task)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `INSERT INTO operations (kind, task_id, task, completion_id, created_at, owner_id) VALUES (?, ?, ?, ?, ?, ` + taskOwner + `)`
+	result, err := s.conn(ctx).ExecContext(ctx, query, op.Kind, op.TaskID, snapshot, op.CompletionID, op.CreatedAt, op.TaskID)
+	if err != nil {
+		return 0, fmt.Errorf("cannot insert operation in database: %w", err)
+	}
+
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("cannot take last insert id: %w", err)
+	}
+
+	return lastID, nil
+}
+
+// LastOperation retrieves the most recent operation made at or after the given RFC 3339 time.
+//
+// Returns:
+// - models.Operation: The operation if found.
+// - error: Returns nil if there is no such operation, or a wrapped error if a database operation fails.
+func (s TaskStorage) LastOperation(ctx context.Context, since string) (models.Operation, error) {
+	owner, ownerArgs := owned(ctx, "owner_id")
+	query := `SELECT id, kind, task_id, task, completion_id, created_at FROM operations WHERE created_at >= ?` + owner + ` ORDER BY id DESC LIMIT 1`
+	row := s.conn(ctx).QueryRowContext(ctx, query, append([]any{since}, ownerArgs...)...)
+
+	var (
+		op       models.Operation
+		snapshot string
+	)
+	err := row.Scan(&op.ID, &op.Kind, &op.TaskID, &snapshot, &op.CompletionID, &op.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Operation{}, nil
+	}
+	if err != nil {
+		return models.Operation{}, fmt.Errorf("cannot read operation from database: %w", err)
+	}
+
+	if op.Task, err = decodeSnapshot(snapshot); err != nil {
+		return models.Operation{}, err
+	}
+
+	return op, nil
+}
+
+// DeleteOperation removes a recorded operation by its ID.
+//
+// Returns:
+// - error: Wrapped error if the deletion fails.
+func (s TaskStorage) DeleteOperation(ctx context.Context, id int64) error {
+	owner, ownerArgs := owned(ctx, "owner_id")
+	query := `DELETE FROM operations WHERE id = ?` + owner
+	_, err := s.conn(ctx).ExecContext(ctx, query, append([]any{id}, ownerArgs...)...)
+	if err != nil {
+		return fmt.Errorf("failed to delete operation: %w", err)
+	}
+	return nil
+}
+
+// DeleteOperations removes the operations made before the given RFC 3339 time.
+//
+// Returns:
+// - error: Wrapped error if the deletion fails.
+func (s TaskStorage) DeleteOperations(ctx context.Context, before string) error {
+	query := `DELETE FROM operations WHERE created_at < ?`
+	_, err := s.conn(ctx).ExecContext(ctx, query, before)
+	if err != nil {
+		return fmt.Errorf("failed to delete operations: %w", err)
+	}
+	return nil
+}
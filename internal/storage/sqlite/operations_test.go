@@ -0,0 +1,247 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_CreateOperation(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("INSERT INTO operations (kind, task_id, task, completion_id, created_at, owner_id) VALUES (?, ?, ?, ?, ?, COALESCE((SELECT owner_id FROM scheduler WHERE id = ?), 0))")
+
+	tests := []struct {
+		name    string
+		op      models.Operation
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantID  int64
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "operation with a snapshot",
+			op: models.Operation{
+				Kind:         models.OperationComplete,
+				TaskID:       7,
+				Task:         &models.Task{ID: 7, Date: "20250410", Title: "Title", Repeat: "d 7", Anchor: "due", Occurrence: 1, Priority: 3, Status: "todo"},
+				CompletionID: 2,
+				CreatedAt:    "2025-04-10T10:30:00Z",
+			},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).
+					WithArgs("complete", 7, `{"id":7,"date":"20250410","title":"Title","comment":"","repeat":"d 7","anchor":"due","occurrence":1,"priority":3,"status":"todo"}`, 2, "2025-04-10T10:30:00Z", 7).
+					WillReturnResult(sqlmock.NewResult(5, 1))
+			},
+			wantID:  5,
+			wantErr: require.NoError,
+		},
+		{
+			name: "operation without a snapshot",
+			op:   models.Operation{Kind: models.OperationRegister, TaskID: 7, CreatedAt: "2025-04-10T10:30:00Z"},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).
+					WithArgs("register", 7, "", 0, "2025-04-10T10:30:00Z", 7).
+					WillReturnResult(sqlmock.NewResult(6, 1))
+			},
+			wantID:  6,
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			op:   models.Operation{Kind: models.OperationRegister, TaskID: 7, CreatedAt: "2025-04-10T10:30:00Z"},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).
+					WithArgs("register", 7, "", 0, "2025-04-10T10:30:00Z", 7).
+					WillReturnError(errors.New("database error"))
+			},
+			wantID: 0,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot insert operation in database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			id, err := storage.CreateOperation(context.Background(), tt.op)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantID, id)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_LastOperation(t *testing.T) {
+	t.Parallel()
+
+	const since = "2025-04-10T10:20:00Z"
+	columns := []string{"id", "kind", "task_id", "task", "completion_id", "created_at"}
+	query := regexp.QuoteMeta("SELECT id, kind, task_id, task, completion_id, created_at FROM operations WHERE created_at >= ? ORDER BY id DESC LIMIT 1")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantOp  models.Operation
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "operation with a snapshot",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(5, "update", 7, `{"id":7,"date":"20250410","title":"Title","occurrence":2}`, 0, "2025-04-10T10:30:00Z")
+				dbMock.ExpectQuery(query).WithArgs(since).WillReturnRows(rows)
+			},
+			wantOp: models.Operation{
+				ID:        5,
+				Kind:      models.OperationUpdate,
+				TaskID:    7,
+				Task:      &models.Task{ID: 7, Date: "20250410", Title: "Title", Occurrence: 2},
+				CreatedAt: "2025-04-10T10:30:00Z",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "operation without a snapshot",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(6, "register", 7, "", 0, "2025-04-10T10:30:00Z")
+				dbMock.ExpectQuery(query).WithArgs(since).WillReturnRows(rows)
+			},
+			wantOp:  models.Operation{ID: 6, Kind: models.OperationRegister, TaskID: 7, CreatedAt: "2025-04-10T10:30:00Z"},
+			wantErr: require.NoError,
+		},
+		{
+			name: "no operations",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(since).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			wantOp:  models.Operation{},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(since).WillReturnError(errors.New("database error"))
+			},
+			wantOp: models.Operation{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot read operation from database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			op, err := storage.LastOperation(context.Background(), since)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantOp, op)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_DeleteOperations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		call    func(storage sqlite.TaskStorage) error
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "single operation",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM operations WHERE id = ?")).
+					WithArgs(5).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			call: func(storage sqlite.TaskStorage) error {
+				return storage.DeleteOperation(context.Background(), 5)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "single operation - database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM operations WHERE id = ?")).
+					WithArgs(5).
+					WillReturnError(errors.New("database error"))
+			},
+			call: func(storage sqlite.TaskStorage) error {
+				return storage.DeleteOperation(context.Background(), 5)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to delete operation: database error", i...)
+			},
+		},
+		{
+			name: "expired operations",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM operations WHERE created_at < ?")).
+					WithArgs("2025-04-10T10:20:00Z").
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+			call: func(storage sqlite.TaskStorage) error {
+				return storage.DeleteOperations(context.Background(), "2025-04-10T10:20:00Z")
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "expired operations - database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM operations WHERE created_at < ?")).
+					WithArgs("2025-04-10T10:20:00Z").
+					WillReturnError(errors.New("database error"))
+			},
+			call: func(storage sqlite.TaskStorage) error {
+				return storage.DeleteOperations(context.Background(), "2025-04-10T10:20:00Z")
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to delete operations: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = tt.call(storage)
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// CreateProject adds a new project owned by the user carried by ctx.
+//
+// Returns:
+// - int64: ID of the created project.
+// - error: Wrapped error if the insert fails.
+func (s TaskStorage) CreateProject(ctx context.Context, p models.Project) (int64, error) {
+	owner, _ := ownerID(ctx)
+	query := `INSERT INTO projects (name, color, archived, owner_id) VALUES (?, ?, ?, ?)`
+	result, err := s.conn(ctx).ExecContext(ctx, query, p.Name, p.Color, p.Archived, owner)
+	if err != nil {
+		return 0, fmt.Errorf("cannot insert project in database: %w", err)
+	}
+
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("cannot take last insert id: %w", err)
+	}
+
+	return lastID, nil
+}
+
+// ReadProject retrieves a project by its ID.
+//
+// Returns:
+// - models.Project: The project if found.
+// - error: An error of the domain.ErrNotFound kind if there is no such project, or a wrapped error if a database operation fails.
+func (s TaskStorage) ReadProject(ctx context.Context, id int64) (models.Project, error) {
+	owner, ownerArgs := owned(ctx, "owner_id")
+	query := `SELECT id, name, color, archived FROM projects WHERE id = ?` + owner
+
+	var p models.Project
+	err := s.conn(ctx).QueryRowContext(ctx, query, append([]any{id}, ownerArgs...)...).Scan(&p.ID, &p.Name, &p.Color, &p.Archived)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Project{}, projectNotFound(id)
+	}
+	if err != nil {
+		return models.Project{}, fmt.Errorf("cannot read project from database: %w", err)
+	}
+
+	return p, nil
+}
+
+// ReadProjects retrieves all projects, active ones first, in alphabetical order.
+//
+// Returns:
+// - []models.Project: A slice of projects.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) ReadProjects(ctx context.Context) ([]models.Project, error) {
+	query := `SELECT id, name, color, archived FROM projects`
+	var args []any
+	if owner, ok := ownerID(ctx); ok {
+		query += ` WHERE owner_id = ?`
+		args = append(args, owner)
+	}
+	query += ` ORDER BY archived, name, id`
+	rows, err := s.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return make([]models.Project, 0), fmt.Errorf("cannot execute query: %w", err)
+	}
+	defer rows.Close()
+
+	projects := make([]models.Project, 0)
+	for rows.Next() {
+		var p models.Project
+		if err := rows.Scan(&p.ID, &p.Name, &p.Color, &p.Archived); err != nil {
+			return make([]models.Project, 0), fmt.Errorf("cannot read row: %w", err)
+		}
+		projects = append(projects, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return make([]models.Project, 0), fmt.Errorf("cannot read projects: %w", err)
+	}
+
+	return projects, nil
+}
+
+// UpdateProject changes the name, colour and archive state of a project.
+//
+// Returns:
+// - error: An error of the domain.ErrNotFound kind if there is no project with the ID, or a wrapped error if the update fails.
+func (s TaskStorage) UpdateProject(ctx context.Context, p models.Project) error {
+	owner, ownerArgs := owned(ctx, "owner_id")
+	query := `UPDATE projects SET name = ?, color = ?, archived = ? WHERE id = ?` + owner
+	result, err := s.conn(ctx).ExecContext(ctx, query, append([]any{p.Name, p.Color, p.Archived, p.ID}, ownerArgs...)...)
+	if err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+
+	return expectAffected(result, projectNotFound(p.ID))
+}
+
+// DeleteProject removes a project. Its tasks, including those in the trash, are kept without a project
+// and get a new version.
+//
+// Returns:
+// - error: An error of the domain.ErrNotFound kind if there is no project with the ID, or a wrapped error if any statement fails.
+// Nothing is changed in either case.
+func (s TaskStorage) DeleteProject(ctx context.Context, id int64) error {
+	return s.InTx(ctx, func(ctx context.Context) error {
+		owner, ownerArgs := owned(ctx, "owner_id")
+		query := `UPDATE scheduler SET project_id = 0, version = version + 1 WHERE project_id = ?` + owner
+		if _, err := s.conn(ctx).ExecContext(ctx, query, append([]any{id}, ownerArgs...)...); err != nil {
+			return fmt.Errorf("failed to delete project: %w", err)
+		}
+
+		result, err := s.conn(ctx).ExecContext(ctx, `DELETE FROM projects WHERE id = ?`+owner, append([]any{id}, ownerArgs...)...)
+		if err != nil {
+			return fmt.Errorf("failed to delete project: %w", err)
+		}
+		return expectAffected(result, projectNotFound(id))
+	})
+}
+
+// projectNotFound reports that there is no project with the ID.
+func projectNotFound(id int64) error {
+	return domain.New(domain.ErrNotFound, fmt.Sprintf("project %d not found", id))
+}
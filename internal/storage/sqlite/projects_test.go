@@ -0,0 +1,330 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/lib/identity"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_CreateProject(t *testing.T) {
+	t.Parallel()
+
+	project := models.Project{Name: "Garden", Color: "#2e8b57"}
+	query := regexp.QuoteMeta("INSERT INTO projects (name, color, archived, owner_id) VALUES (?, ?, ?, ?)")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantID  int64
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "successful creation",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("Garden", "#2e8b57", false, 0).WillReturnResult(sqlmock.NewResult(2, 1))
+			},
+			wantID:  2,
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("Garden", "#2e8b57", false, 0).WillReturnError(errors.New("database error"))
+			},
+			wantID: 0,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot insert project in database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			id, err := storage.CreateProject(context.Background(), project)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantID, id)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_ReadProject(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "name", "color", "archived"}
+	query := regexp.QuoteMeta("SELECT id, name, color, archived FROM projects WHERE id = ?")
+
+	tests := []struct {
+		name        string
+		mocks       func(dbMock sqlmock.Sqlmock)
+		wantProject models.Project
+		wantErr     require.ErrorAssertionFunc
+	}{
+		{
+			name: "project found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows(columns).AddRow(2, "Garden", "#2e8b57", true))
+			},
+			wantProject: models.Project{ID: 2, Name: "Garden", Color: "#2e8b57", Archived: true},
+			wantErr:     require.NoError,
+		},
+		{
+			name: "project not found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			wantProject: models.Project{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+				require.EqualError(tt, err, "project 2 not found", i...)
+			},
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(2).WillReturnError(errors.New("database error"))
+			},
+			wantProject: models.Project{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot read project from database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			project, err := storage.ReadProject(context.Background(), 2)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantProject, project)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_ReadProjects(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("SELECT id, name, color, archived FROM projects ORDER BY archived, name, id")
+
+	tests := []struct {
+		name         string
+		mocks        func(dbMock sqlmock.Sqlmock)
+		wantProjects []models.Project
+		wantErr      require.ErrorAssertionFunc
+	}{
+		{
+			name: "projects",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "color", "archived"}).
+					AddRow(2, "Garden", "#2e8b57", false).
+					AddRow(1, "Move", "", true)
+				dbMock.ExpectQuery(query).WillReturnRows(rows)
+			},
+			wantProjects: []models.Project{
+				{ID: 2, Name: "Garden", Color: "#2e8b57"},
+				{ID: 1, Name: "Move", Archived: true},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WillReturnError(errors.New("database error"))
+			},
+			wantProjects: []models.Project{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			projects, err := storage.ReadProjects(context.Background())
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantProjects, projects)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_UpdateProject(t *testing.T) {
+	t.Parallel()
+
+	project := models.Project{ID: 2, Name: "Garden", Color: "#2e8b57", Archived: true}
+	query := regexp.QuoteMeta("UPDATE projects SET name = ?, color = ?, archived = ? WHERE id = ?")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "project updated",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("Garden", "#2e8b57", true, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "project not found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("Garden", "#2e8b57", true, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+				require.EqualError(tt, err, "project 2 not found", i...)
+			},
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("Garden", "#2e8b57", true, 2).WillReturnError(errors.New("database error"))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to update project: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.UpdateProject(context.Background(), project)
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_DeleteProject(t *testing.T) {
+	t.Parallel()
+
+	var (
+		unassignQuery = regexp.QuoteMeta("UPDATE scheduler SET project_id = 0, version = version + 1 WHERE project_id = ?")
+		deleteQuery   = regexp.QuoteMeta("DELETE FROM projects WHERE id = ?")
+	)
+
+	tests := []struct {
+		name    string
+		user    identity.User
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "project deleted",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(unassignQuery).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 5))
+				dbMock.ExpectExec(deleteQuery).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+				dbMock.ExpectCommit()
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "project of a user deleted",
+			user: identity.User{ID: 3, Name: "alice"},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(regexp.QuoteMeta("UPDATE scheduler SET project_id = 0, version = version + 1 WHERE project_id = ? AND owner_id = ?")).
+					WithArgs(2, 3).
+					WillReturnResult(sqlmock.NewResult(0, 5))
+				dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM projects WHERE id = ? AND owner_id = ?")).
+					WithArgs(2, 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				dbMock.ExpectCommit()
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "project not found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(unassignQuery).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+				dbMock.ExpectExec(deleteQuery).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+				dbMock.ExpectRollback()
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+				require.EqualError(tt, err, "project 2 not found", i...)
+			},
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(unassignQuery).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 5))
+				dbMock.ExpectExec(deleteQuery).WithArgs(2).WillReturnError(errors.New("database error"))
+				dbMock.ExpectRollback()
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to delete project: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			ctx := context.Background()
+			if tt.user.ID != 0 {
+				ctx = identity.WithUser(ctx, tt.user)
+			}
+			err = storage.DeleteProject(ctx, 2)
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+// ReadRemindedTasks retrieves the tasks outside the trash which have reminders and are not done yet,
+// each with the time zone of its owner.
+//
+// Returns:
+// - []models.Task: A slice of tasks.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) ReadRemindedTasks(ctx context.Context) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `, ` + ownerTimezoneColumn + `
+		FROM scheduler
+		WHERE reminders != '' AND status != ? AND deleted_at IS NULL
+		ORDER BY date, id`
+	return s.queryOwnedTasks(ctx, query, models.StatusDone)
+}
+
+// ownerTimezoneColumn selects the time zone of the account owning a task, empty for tasks without an owner.
+const ownerTimezoneColumn = `IFNULL((SELECT timezone FROM users WHERE users.id = scheduler.owner_id), '')`
+
+// queryOwnedTasks runs a query selecting the task columns followed by ownerTimezoneColumn
+// and fills in the time zone of the owner of every task.
+func (s TaskStorage) queryOwnedTasks(ctx context.Context, query string, args ...any) ([]models.Task, error) {
+	rows, err := s.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return make([]models.Task, 0), fmt.Errorf("cannot execute query: %w", err)
+	}
+	defer rows.Close()
+
+	tasks := make([]models.Task, 0)
+	for rows.Next() {
+		var timezone string
+		task, err := scanTask(rows, &timezone)
+		if err != nil {
+			return make([]models.Task, 0), fmt.Errorf("cannot read row: %w", err)
+		}
+		task.OwnerTimezone = timezone
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return make([]models.Task, 0), fmt.Errorf("cannot read tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// ReadSentReminders retrieves the reminders due at or after the given RFC 3339 time which have already been sent.
+// Only the task ID and the time of each reminder are filled in.
+//
+// Returns:
+// - []models.Reminder: A slice of sent reminders.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) ReadSentReminders(ctx context.Context, since string) ([]models.Reminder, error) {
+	query := `SELECT task_id, remind_at FROM reminders WHERE remind_at >= ? ORDER BY remind_at, task_id`
+	rows, err := s.conn(ctx).QueryContext(ctx, query, since)
+	if err != nil {
+		return make([]models.Reminder, 0), fmt.Errorf("cannot execute query: %w", err)
+	}
+	defer rows.Close()
+
+	reminders := make([]models.Reminder, 0)
+	for rows.Next() {
+		var r models.Reminder
+		if err := rows.Scan(&r.TaskID, &r.RemindAt); err != nil {
+			return make([]models.Reminder, 0), fmt.Errorf("cannot read row: %w", err)
+		}
+		reminders = append(reminders, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return make([]models.Reminder, 0), fmt.Errorf("cannot read reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// CreateSentReminder records that a reminder has been sent at the given RFC 3339 time.
+// Recording the same reminder twice is not an error.
+//
+// Returns:
+// - error: Wrapped error if the insert fails.
+func (s TaskStorage) CreateSentReminder(ctx context.Context, r models.Reminder, sentAt string) error {
+	query := `INSERT OR IGNORE INTO reminders (task_id, remind_at, sent_at) VALUES (?, ?, ?)`
+	if _, err := s.conn(ctx).ExecContext(ctx, query, r.TaskID, r.RemindAt, sentAt); err != nil {
+		return fmt.Errorf("cannot insert reminder in database: %w", err)
+	}
+	return nil
+}
+
+// DeleteSentReminders removes the records of the reminders due before the given RFC 3339 time.
+//
+// Returns:
+// - error: Wrapped error if the deletion fails.
+func (s TaskStorage) DeleteSentReminders(ctx context.Context, before string) error {
+	query := `DELETE FROM reminders WHERE remind_at < ?`
+	if _, err := s.conn(ctx).ExecContext(ctx, query, before); err != nil {
+		return fmt.Errorf("failed to delete reminders: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_ReadRemindedTasks(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking", "timezone"}
+	query := regexp.QuoteMeta(`, IFNULL((SELECT timezone FROM users WHERE users.id = scheduler.owner_id), '') FROM scheduler WHERE reminders != '' AND status != ? AND deleted_at IS NULL ORDER BY date, id`)
+
+	tests := []struct {
+		name      string
+		mocks     func(dbMock sqlmock.Sqlmock)
+		wantTasks []models.Task
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "tasks with reminders",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(3, "20240203", "Dentist", "", "", "due", "", 1, 3, "todo", 0, 0, "14:30", "15,1440", 1, nil, nil, nil, nil, "Europe/Berlin").
+					AddRow(4, "20240203", "Call", "", "", "due", "", 1, 3, "todo", 0, 0, "", "5", 1, nil, nil, nil, nil, "")
+				dbMock.ExpectQuery(query).WithArgs("done").WillReturnRows(rows)
+			},
+			wantTasks: []models.Task{
+				{ID: 3, Date: "20240203", Time: "14:30", Title: "Dentist", Anchor: "due", Occurrence: 1, Priority: 3, Status: "todo", Reminders: []int{15, 1440}, Version: 1, OwnerTimezone: "Europe/Berlin"},
+				{ID: 4, Date: "20240203", Title: "Call", Anchor: "due", Occurrence: 1, Priority: 3, Status: "todo", Reminders: []int{5}, Version: 1},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "invalid reminder offset",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(3, "20240203", "Dentist", "", "", "due", "", 1, 3, "todo", 0, 0, "14:30", "soon", 1, nil, nil, nil, nil, "")
+				dbMock.ExpectQuery(query).WithArgs("done").WillReturnRows(rows)
+			},
+			wantTasks: []models.Task{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorContains(tt, err, `invalid reminder offset "soon"`, i...)
+			},
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs("done").WillReturnError(errors.New("database error"))
+			},
+			wantTasks: []models.Task{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			tasks, err := storage.ReadRemindedTasks(context.Background())
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantTasks, tasks)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_ReadSentReminders(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("SELECT task_id, remind_at FROM reminders WHERE remind_at >= ? ORDER BY remind_at, task_id")
+	since := "2024-02-03T13:00:00Z"
+
+	tests := []struct {
+		name          string
+		mocks         func(dbMock sqlmock.Sqlmock)
+		wantReminders []models.Reminder
+		wantErr       require.ErrorAssertionFunc
+	}{
+		{
+			name: "sent reminders",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"task_id", "remind_at"}).
+					AddRow(3, "2024-02-03T14:15:00Z")
+				dbMock.ExpectQuery(query).WithArgs(since).WillReturnRows(rows)
+			},
+			wantReminders: []models.Reminder{{TaskID: 3, RemindAt: "2024-02-03T14:15:00Z"}},
+			wantErr:       require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(since).WillReturnError(errors.New("database error"))
+			},
+			wantReminders: []models.Reminder{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			reminders, err := storage.ReadSentReminders(context.Background(), since)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantReminders, reminders)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_CreateSentReminder(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("INSERT OR IGNORE INTO reminders (task_id, remind_at, sent_at) VALUES (?, ?, ?)")
+	reminder := models.Reminder{TaskID: 3, RemindAt: "2024-02-03T14:15:00Z"}
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "reminder recorded",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(3, "2024-02-03T14:15:00Z", "2024-02-03T14:15:02Z").WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(3, "2024-02-03T14:15:00Z", "2024-02-03T14:15:02Z").WillReturnError(errors.New("database error"))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot insert reminder in database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.CreateSentReminder(context.Background(), reminder, "2024-02-03T14:15:02Z")
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_DeleteSentReminders(t *testing.T) {
+	t.Parallel()
+
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM reminders WHERE remind_at < ?")).
+		WithArgs("2024-02-03T13:00:00Z").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = sqlite.New(db, 3).DeleteSentReminders(context.Background(), "2024-02-03T13:00:00Z")
+	require.NoError(t, err)
+
+	require.NoError(t, dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,20 @@
+package sqlite
+
+import (
+	"context"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+// ReadOverdue retrieves the recurring top-level tasks outside the trash which are anchored to their due date
+// and scheduled before the given date in YYYYMMDD format, oldest first, each with the time zone of its owner.
+//
+// Returns:
+// - []models.Task: A slice of overdue tasks.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) ReadOverdue(ctx context.Context, before string) ([]models.Task, error) {
+	query := `SELECT ` + taskColumns + `, ` + ownerTimezoneColumn + ` FROM scheduler
+		WHERE repeat != '' AND anchor != ? AND parent_id = 0 AND deleted_at IS NULL AND date < ?
+		ORDER BY date, id`
+	return s.queryOwnedTasks(ctx, query, models.AnchorCompletion, before)
+}
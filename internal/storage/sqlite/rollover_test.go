@@ -0,0 +1,68 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_ReadOverdue(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking", "timezone"}
+	query := `FROM scheduler\s+WHERE repeat != '' AND anchor != \? AND parent_id = 0 AND deleted_at IS NULL AND date < \?\s+ORDER BY date, id`
+
+	tests := []struct {
+		name      string
+		mocks     func(dbMock sqlmock.Sqlmock)
+		wantTasks []models.Task
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "overdue tasks",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(4, "20240120", "Water plants", "", "d 1", "due", "", 3, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil, "America/Chicago")
+				dbMock.ExpectQuery(query).WithArgs("completion", "20240203").WillReturnRows(rows)
+			},
+			wantTasks: []models.Task{
+				{ID: 4, Date: "20240120", Title: "Water plants", Repeat: "d 1", Anchor: "due", Occurrence: 3, Priority: 3, Status: "todo", Version: 1, OwnerTimezone: "America/Chicago"},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs("completion", "20240203").WillReturnError(errors.New("database error"))
+			},
+			wantTasks: []models.Task{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			tasks, err := storage.ReadOverdue(context.Background(), "20240203")
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantTasks, tasks)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
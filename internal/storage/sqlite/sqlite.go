@@ -5,12 +5,136 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/10Narratives/task-tracker/internal/lib/identity"
 	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/10Narratives/task-tracker/migrations"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// taskColumns lists the scheduler columns in the order expected by scanTask.
+// The names of the tags of a task and the IDs of its blockers and of the tasks it blocks
+// are collected into the last three, comma separated columns.
+const taskColumns = `id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, ` +
+	taskTags + `, ` + taskBlockers + `, ` + taskBlocking
+
+// taskTags is the subquery collecting the tag names of the task in the current scheduler row.
+const taskTags = `(SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags`
+
+// taskBlockers and taskBlocking are the subqueries collecting the IDs of the tasks outside the trash
+// which block the task in the current scheduler row and which are blocked by it.
+const (
+	taskBlockers = `(SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by`
+	taskBlocking = `(SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking`
+)
+
+// taskOwner is the subquery taking the owner of the task with the ID given as its argument.
+const taskOwner = `COALESCE((SELECT owner_id FROM scheduler WHERE id = ?), 0)`
+
+// nowUTC is the SQL expression for the current time in RFC 3339 format, UTC.
+const nowUTC = `strftime('%Y-%m-%dT%H:%M:%SZ', 'now')`
+
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTask reads a row of taskColumns followed by the extra columns, which are scanned into extra.
+func scanTask(row scanner, extra ...any) (models.Task, error) {
+	var (
+		task      models.Task
+		exDates   string
+		deletedAt sql.NullString
+		reminders string
+		tags      sql.NullString
+		blockedBy sql.NullString
+		blocking  sql.NullString
+	)
+	dest := []any{&task.ID, &task.Date, &task.Title, &task.Comment, &task.Repeat, &task.Anchor, &exDates, &task.Occurrence, &task.Priority, &task.Status, &task.ProjectID, &task.ParentID, &task.Time, &reminders, &task.Version, &deletedAt, &tags, &blockedBy, &blocking}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return task, err
+	}
+	task.ExDates = splitDates(exDates)
+	if task.Reminders, err = splitMinutes(reminders); err != nil {
+		return task, err
+	}
+	task.DeletedAt = deletedAt.String
+	task.Tags = splitDates(tags.String)
+	slices.Sort(task.Tags)
+	if task.BlockedBy, err = splitIDs(blockedBy.String); err != nil {
+		return task, err
+	}
+	task.Blocking, err = splitIDs(blocking.String)
+	return task, err
+}
+
+// joinMinutes and splitMinutes convert reminder offsets to and from the comma separated form kept in the database.
+func joinMinutes(minutes []int) string {
+	parts := make([]string, len(minutes))
+	for i, m := range minutes {
+		parts[i] = strconv.Itoa(m)
+	}
+	return strings.Join(parts, ",")
+}
+
+func splitMinutes(minutes string) ([]int, error) {
+	if minutes == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(minutes, ",")
+	parsed := make([]int, 0, len(parts))
+	for _, part := range parts {
+		m, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reminder offset %q: %w", part, err)
+		}
+		parsed = append(parsed, m)
+	}
+	return parsed, nil
+}
+
+// splitIDs parses the comma separated task IDs collected by taskBlockers and taskBlocking in ascending order.
+func splitIDs(ids string) ([]int64, error) {
+	if ids == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(ids, ",")
+	parsed := make([]int64, 0, len(parts))
+	for _, part := range parts {
+		id, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid task id %q: %w", part, err)
+		}
+		parsed = append(parsed, id)
+	}
+	slices.Sort(parsed)
+	return parsed, nil
+}
+
+// joinDates and splitDates convert a list of dates to and from the comma separated form kept in the database.
+// splitDates also splits the tag names collected by taskTags.
+func joinDates(dates []string) string {
+	return strings.Join(dates, ",")
+}
+
+func splitDates(dates string) []string {
+	if dates == "" {
+		return nil
+	}
+	return strings.Split(dates, ",")
+}
+
 type TaskStorage struct {
 	Limit uint    // Maximum number of tasks to fetch in a group.
 	DB    *sql.DB // Database connection used to interact with the scheduler table.
@@ -28,40 +152,105 @@ func New(db *sql.DB, limit uint) TaskStorage {
 	return TaskStorage{DB: db, Limit: limit}
 }
 
-// Prepare initializes the database by creating the 'scheduler' table and an index on the 'date' column if they do not exist.
+// Prepare brings the database schema up to date by applying the migrations from the migrations directory
+// which have not been applied yet, the same ones cmd/migrator applies. A database created before there were
+// migrations is taken as being at the first one, whose statements leave existing tables alone.
 //
 // Returns:
-// - error: An error if the table creation or index setup fails.
+// - error: An error if the migrations cannot be read or one of them fails.
 func (s TaskStorage) Prepare() error {
-	query := `
-	CREATE TABLE IF NOT EXISTS scheduler (
-    	id INTEGER PRIMARY KEY AUTOINCREMENT,
-    	date TEXT NOT NULL,
-    	title TEXT NOT NULL,
-    	comment TEXT,
-    	repeat TEXT CHECK(LENGTH(repeat) <= 128)
-	);
-
-	CREATE INDEX IF NOR EXISTS idx_scheduler_date ON scheduler(date);
-	`
-
-	stmt, err := s.DB.Prepare(query)
+	source, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return fmt.Errorf("can not read migrations: %w", err)
+	}
 
+	driver, err := sqlite3.WithInstance(s.DB, &sqlite3.Config{})
 	if err != nil {
-		return fmt.Errorf("can not prepare statement: %w", err)
+		return fmt.Errorf("can not prepare database: %w", err)
 	}
 
-	_, err = stmt.Exec()
+	// The migrate instance is not closed, since closing it would close the database as well.
+	m, err := migrate.NewWithInstance("iofs", source, "sqlite3", driver)
 	if err != nil {
 		return fmt.Errorf("can not prepare database: %w", err)
 	}
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("can not migrate database: %w", err)
+	}
 
 	return nil
 }
 
-func (s TaskStorage) Create(ctx context.Context, date, title, comment, repeat string) (int64, error) {
-	query := `INSERT INTO scheduler (date, title, comment, repeat) VALUES (?, ?, ?, ?)`
-	result, err := s.DB.ExecContext(ctx, query, date, title, comment, repeat)
+// querier is implemented by *sql.DB and *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+// conn returns the transaction started by InTx if ctx carries one and the database otherwise.
+func (s TaskStorage) conn(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return s.DB
+}
+
+// ownerID returns the ID of the user carried by ctx. Storage calls made on behalf of a user
+// see and change only the records the user owns, while calls made without one,
+// such as those of the background workers, reach the records of every user.
+func ownerID(ctx context.Context) (int64, bool) {
+	user, ok := identity.FromContext(ctx)
+	return user.ID, ok
+}
+
+// owned returns the condition limiting a query to the rows whose column holds the ID of the user carried by ctx,
+// together with its argument. Both are empty for calls made without a user.
+func owned(ctx context.Context, column string) (string, []any) {
+	id, ok := ownerID(ctx)
+	if !ok {
+		return "", nil
+	}
+	return ` AND ` + column + ` = ?`, []any{id}
+}
+
+// InTx runs fn in a database transaction. Storage calls made with the context passed to fn
+// take part in the transaction, which is committed if fn returns nil and rolled back otherwise.
+func (s TaskStorage) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("cannot roll back transaction: %w", rbErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("cannot commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Create inserts a task owned by the user carried by ctx.
+// Tasks created without a user belong to nobody until the admin account created at start adopts them.
+//
+// Returns:
+// - int64: ID of the created task.
+// - error: Wrapped error if the insert fails.
+func (s TaskStorage) Create(ctx context.Context, t models.Task) (int64, error) {
+	owner, _ := ownerID(ctx)
+	query := `INSERT INTO scheduler (date, title, comment, repeat, anchor, exdates, priority, status, project_id, parent_id, time, reminders, owner_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := s.conn(ctx).ExecContext(ctx, query, t.Date, t.Title, t.Comment, t.Repeat, t.Anchor, joinDates(t.ExDates), t.Priority, t.Status, t.ProjectID, t.ParentID, t.Time, joinMinutes(t.Reminders), owner)
 	if err != nil {
 		return 0, fmt.Errorf("cannot insert task in database: %w", err)
 	}
@@ -81,16 +270,16 @@ func (s TaskStorage) Create(ctx context.Context, date, title, comment, repeat st
 // - id: Unique identifier of the task to be retrieved.
 //
 // Returns:
-// - models.Task: The retrieved task if found.
-// - error: Returns nil if no task is found, or a wrapped error if a database operation fails.
+// - models.Task: The retrieved task if found. Tasks in the trash are not found.
+// - error: An error of the domain.ErrNotFound kind if no task is found, or a wrapped error if a database operation fails.
 func (s TaskStorage) Read(ctx context.Context, id int64) (models.Task, error) {
-	query := `SELECT id, date, title, comment, repeat FROM scheduler WHERE id = ?`
-	row := s.DB.QueryRow(query, id)
-	task := models.Task{}
+	owner, ownerArgs := owned(ctx, "owner_id")
+	query := `SELECT ` + taskColumns + ` FROM scheduler WHERE id = ? AND deleted_at IS NULL` + owner
+	row := s.conn(ctx).QueryRowContext(ctx, query, append([]any{id}, ownerArgs...)...)
 
-	err := row.Scan(&task.ID, &task.Date, &task.Title, &task.Comment, &task.Repeat)
+	task, err := scanTask(row)
 	if errors.Is(err, sql.ErrNoRows) {
-		return models.Task{}, nil
+		return models.Task{}, taskNotFound(id)
 	}
 
 	if err != nil {
@@ -101,7 +290,7 @@ func (s TaskStorage) Read(ctx context.Context, id int64) (models.Task, error) {
 }
 
 func (s TaskStorage) queryTasks(ctx context.Context, query string, args ...interface{}) ([]models.Task, error) {
-	rows, err := s.DB.QueryContext(ctx, query, args...)
+	rows, err := s.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return make([]models.Task, 0), fmt.Errorf("cannot execute query: %w", err)
 	}
@@ -109,9 +298,7 @@ func (s TaskStorage) queryTasks(ctx context.Context, query string, args ...inter
 
 	tasks := make([]models.Task, 0)
 	for rows.Next() {
-		task := models.Task{}
-
-		err := rows.Scan(&task.ID, &task.Date, &task.Title, &task.Comment, &task.Repeat)
+		task, err := scanTask(rows)
 		if err != nil {
 			return make([]models.Task, 0), fmt.Errorf("cannot read row: %w", err)
 		}
@@ -126,91 +313,193 @@ func (s TaskStorage) queryTasks(ctx context.Context, query string, args ...inter
 	return tasks, nil
 }
 
-// ReadGroup retrieves a limited number of tasks ordered by date.
+// selectTasks builds a query for the top-level tasks outside the trash which satisfy the given conditions and the filter.
+// The tasks are ordered as the filter says and limited to s.Limit. Subtasks are only read together with their parent.
+func (s TaskStorage) selectTasks(ctx context.Context, conditions []string, args []any, filter models.TaskFilter) (string, []any) {
+	conditions = append(conditions, "deleted_at IS NULL", "parent_id = 0")
+	if owner, ok := ownerID(ctx); ok {
+		conditions = append(conditions, "owner_id = ?")
+		args = append(args, owner)
+	}
+	if filter.Priority != 0 {
+		conditions = append(conditions, "priority = ?")
+		args = append(args, filter.Priority)
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.ProjectID != 0 {
+		conditions = append(conditions, "project_id = ?")
+		args = append(args, filter.ProjectID)
+	}
+	if len(filter.Tags) > 0 {
+		tagged := `id IN (SELECT task_tags.task_id FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE tags.name IN (` + placeholders(len(filter.Tags)) + `)`
+		for _, tag := range filter.Tags {
+			args = append(args, tag)
+		}
+		if filter.AllTags {
+			tagged += ` GROUP BY task_tags.task_id HAVING COUNT(*) = ?`
+			args = append(args, len(filter.Tags))
+		}
+		conditions = append(conditions, tagged+`)`)
+	}
+
+	query := `SELECT ` + taskColumns + ` FROM scheduler WHERE ` + strings.Join(conditions, " AND ") + ` ORDER BY ` + taskOrder(filter.Sort) + ` LIMIT ?`
+	return query, append(args, s.Limit)
+}
+
+// placeholders returns n comma separated query parameter placeholders.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// taskOrder returns the ORDER BY clause for the given sort order.
+func taskOrder(sort string) string {
+	switch sort {
+	case models.SortByPriority:
+		return "priority, date"
+	case models.SortByStatus:
+		return "CASE status WHEN 'todo' THEN 1 WHEN 'in_progress' THEN 2 WHEN 'blocked' THEN 3 ELSE 4 END, date"
+	default:
+		return "date"
+	}
+}
+
+// ReadGroup retrieves a limited number of tasks matching the filter, leaving out the trash.
 //
 // Parameters:
 // - ctx: Context for request cancellation and timeout control.
+// - filter: Priority, status, project and tags to select and the order of the tasks.
 //
 // Returns:
-// - []models.Task: A slice of retrieved tasks, ordered by date.
+// - []models.Task: A slice of retrieved tasks, ordered by date unless the filter says otherwise.
 // - error: Wrapped error if the query fails.
-func (s TaskStorage) ReadGroup(ctx context.Context) ([]models.Task, error) {
-	query := `SELECT id, date, title, comment, repeat FROM scheduler ORDER BY date LIMIT (?)`
-	return s.queryTasks(ctx, query, s.Limit)
+func (s TaskStorage) ReadGroup(ctx context.Context, filter models.TaskFilter) ([]models.Task, error) {
+	query, args := s.selectTasks(ctx, nil, nil, filter)
+	return s.queryTasks(ctx, query, args...)
 }
 
-// ReadByDate retrieves tasks that match a specific date.
+// ReadByDate retrieves tasks that match a specific date and the filter, leaving out the trash.
 //
 // Parameters:
 // - ctx: Context for request cancellation and timeout control.
 // - date: The date to filter tasks by (must not be empty).
+// - filter: Priority, status, project and tags to select and the order of the tasks.
 //
 // Returns:
 // - []models.Task: A slice of tasks that match the given date
 // - error: Returns ErrEmptyDate if the date is empty or a wrapped error if the query fails.
-func (s TaskStorage) ReadByDate(ctx context.Context, date string) ([]models.Task, error) {
-	query := `SELECT id, date, title, comment, repeat FROM scheduler WHERE date = ? LIMIT ?`
-	return s.queryTasks(ctx, query, date, s.Limit)
+func (s TaskStorage) ReadByDate(ctx context.Context, date string, filter models.TaskFilter) ([]models.Task, error) {
+	query, args := s.selectTasks(ctx, []string{"date = ?"}, []any{date}, filter)
+	return s.queryTasks(ctx, query, args...)
 }
 
-// ReadByPayload retrieves tasks where the title or comment matches the given payload.
+// ReadByPayload retrieves tasks where the title or comment matches the given payload
+// and which satisfy the filter, leaving out the trash.
 //
 // Parameters:
 // - ctx: Context for request cancellation and timeout control.
 // - payload: The search keyword (must not be empty).
+// - filter: Priority, status, project and tags to select and the order of the tasks.
 //
 // Returns:
-// - []models.Task: A slice of matching tasks, ordered by date.
+// - []models.Task: A slice of matching tasks, ordered by date unless the filter says otherwise.
 // - error: Returns ErrEmptyPayload if the payload is empty or a wrapped error if the query fails.
-func (s TaskStorage) ReadByPayload(ctx context.Context, payload string) ([]models.Task, error) {
+func (s TaskStorage) ReadByPayload(ctx context.Context, payload string, filter models.TaskFilter) ([]models.Task, error) {
 	payload = "%" + payload + "%"
-	query := `SELECT id, date, title, comment, repeat FROM scheduler WHERE title LIKE ? OR comment LIKE ? ORDER BY date LIMIT ?`
-	return s.queryTasks(ctx, query, payload, payload, s.Limit)
+	query, args := s.selectTasks(ctx, []string{"(title LIKE ? OR comment LIKE ?)"}, []any{payload, payload}, filter)
+	return s.queryTasks(ctx, query, args...)
 }
 
-// Update modifies an existing task in the scheduler database.
+// Update modifies an existing task in the scheduler database and bumps its version.
+// A task with a version is only written if the stored one still has that version, so that a concurrent writer
+// which changed it since it was read is not overwritten. A zero version writes the task whatever its stored version is.
 //
 // Parameters:
 // - ctx: Context for request cancellation and timeout control.
 // - t: Pointer to the Task model containing updated values (must not be nil).
 //
 // Returns:
-// - error: Returns ErrNilTaskUpdate if t is nil or a wrapped error if the update fails.
+// - error: Returns an error if t is nil, an error of the domain.ErrNotFound kind if there is no task
+// with the ID outside the trash, an error of the domain.ErrPrecondition kind if its version has changed,
+// or a wrapped error if the update fails.
 func (s TaskStorage) Update(ctx context.Context, t *models.Task) error {
 	if t == nil {
 		return fmt.Errorf("cannot update task using nil pointer")
 	}
 
+	owner, ownerArgs := owned(ctx, "owner_id")
+	where := `id = ? AND deleted_at IS NULL`
+	whereArgs := []any{t.ID}
+	if t.Version != 0 {
+		where += ` AND version = ?`
+		whereArgs = append(whereArgs, t.Version)
+	}
 	query := `
 		UPDATE scheduler
-		SET date = ?, title = ?, comment = ?, repeat = ?
-		WHERE id = ?
-	`
+		SET date = ?, title = ?, comment = ?, repeat = ?, anchor = ?, exdates = ?, occurrence = ?, priority = ?, status = ?, project_id = ?, time = ?, reminders = ?, version = version + 1
+		WHERE ` + where + owner
 
-	_, err := s.DB.ExecContext(ctx, query, t.Date, t.Title, t.Comment, t.Repeat, t.ID)
+	args := append([]any{t.Date, t.Title, t.Comment, t.Repeat, t.Anchor, joinDates(t.ExDates), t.Occurrence, t.Priority, t.Status, t.ProjectID, t.Time, joinMinutes(t.Reminders)}, whereArgs...)
+	result, err := s.conn(ctx).ExecContext(ctx, query, append(args, ownerArgs...)...)
 	if err != nil {
 		return fmt.Errorf("failed to update task: %w", err)
 	}
 
-	return nil
+	err = expectAffected(result, taskNotFound(t.ID))
+	if err == nil || t.Version == 0 || !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	// No row matched, either because the task is gone or because another writer has changed its version.
+	var exists bool
+	query = `SELECT EXISTS (SELECT 1 FROM scheduler WHERE id = ? AND deleted_at IS NULL` + owner + `)`
+	if err := s.conn(ctx).QueryRowContext(ctx, query, append([]any{t.ID}, ownerArgs...)...).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+	if !exists {
+		return taskNotFound(t.ID)
+	}
+	return domain.New(domain.ErrPrecondition, fmt.Sprintf("task %d was changed in the meantime: version %d is not the current one", t.ID, t.Version))
 }
 
-// Delete removes a task from the scheduler database by its ID.
+// Delete moves a task to the trash together with its subtasks by stamping them with the same deletion time.
+// Trashed tasks are left out of reads until they are restored or purged.
 //
 // Parameters:
 // - ctx: Context for request cancellation and timeout control.
 // - id: Unique identifier of the task to be deleted.
 //
 // Returns:
-// - error: Wrapped error if the deletion fails.
+// - error: An error of the domain.ErrNotFound kind if there is no task with the ID outside the trash,
+// or a wrapped error if the deletion fails.
 func (s TaskStorage) Delete(ctx context.Context, id int64) error {
+	owner, ownerArgs := owned(ctx, "owner_id")
 	query := `
-		DELETE FROM scheduler
-		WHERE id = ?
-	`
-	_, err := s.DB.ExecContext(ctx, query, id)
+		UPDATE scheduler
+		SET deleted_at = ` + nowUTC + `
+		WHERE deleted_at IS NULL AND (id = ? OR parent_id IN (SELECT id FROM scheduler WHERE id = ? AND deleted_at IS NULL))` + owner
+	result, err := s.conn(ctx).ExecContext(ctx, query, append([]any{id, id}, ownerArgs...)...)
 	if err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
 	}
+	return expectAffected(result, taskNotFound(id))
+}
+
+// taskNotFound reports that there is no task with the ID outside the trash.
+func taskNotFound(id int64) error {
+	return domain.New(domain.ErrNotFound, fmt.Sprintf("task %d not found", id))
+}
+
+// expectAffected returns notFound if the statement changed no rows.
+func expectAffected(result sql.Result, notFound error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("cannot take affected rows: %w", err)
+	}
+	if affected == 0 {
+		return notFound
+	}
 	return nil
 }
@@ -3,11 +3,14 @@ package sqlite_test
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"regexp"
 	"testing"
 
+	"github.com/10Narratives/task-tracker/internal/lib/identity"
 	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
 	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -17,43 +20,38 @@ import (
 func TestTaskStorage_Prepare(t *testing.T) {
 	t.Parallel()
 
+	// baseline is the schema the tracker created before there were migrations.
+	const baseline = `CREATE TABLE scheduler (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		date TEXT NOT NULL,
+		title TEXT NOT NULL,
+		comment TEXT,
+		repeat TEXT CHECK(LENGTH(repeat) <= 128)
+	)`
+
 	tests := []struct {
-		name    string
-		mock    func(dbMock sqlmock.Sqlmock)
-		wantErr require.ErrorAssertionFunc
+		name      string
+		setup     func(t *testing.T, db *sql.DB)
+		wantTasks int
 	}{
 		{
-			name: "success",
-			mock: func(dbMock sqlmock.Sqlmock) {
-				dbMock.ExpectPrepare(`CREATE TABLE IF NOT EXISTS scheduler`).
-					WillReturnError(nil) // No error in preparing statement
-
-				dbMock.ExpectExec(`CREATE TABLE IF NOT EXISTS scheduler`).
-					WillReturnResult(sqlmock.NewResult(0, 0)) // Simulating successful execution
-			},
-			wantErr: require.NoError,
+			name:  "new database",
+			setup: func(t *testing.T, db *sql.DB) {},
 		},
 		{
-			name: "prepare statement error",
-			mock: func(dbMock sqlmock.Sqlmock) {
-				dbMock.ExpectPrepare(`CREATE TABLE IF NOT EXISTS scheduler`).
-					WillReturnError(errors.New("prepare statement error"))
-			},
-			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
-				require.EqualError(tt, err, "can not prepare statement: prepare statement error", i...)
-			},
+			name: "database from before the migrations",
+			setup: func(t *testing.T, db *sql.DB) {
+				_, err := db.Exec(baseline)
+				require.NoError(t, err)
+				_, err = db.Exec(`INSERT INTO scheduler (date, title, comment, repeat) VALUES ('20250101', 'Title', '', 'd 1')`)
+				require.NoError(t, err)
+			},
+			wantTasks: 1,
 		},
 		{
-			name: "exec statement error",
-			mock: func(dbMock sqlmock.Sqlmock) {
-				dbMock.ExpectPrepare(`CREATE TABLE IF NOT EXISTS scheduler`).
-					WillReturnError(nil) // No error in preparing statement
-
-				dbMock.ExpectExec(`CREATE TABLE IF NOT EXISTS scheduler`).
-					WillReturnError(errors.New("exec statement error")) // Error when executing
-			},
-			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
-				require.EqualError(tt, err, "can not prepare database: exec statement error", i...)
+			name: "database already up to date",
+			setup: func(t *testing.T, db *sql.DB) {
+				require.NoError(t, sqlite.New(db, 10).Prepare())
 			},
 		},
 	}
@@ -62,17 +60,18 @@ func TestTaskStorage_Prepare(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			db, dbMock, err := sqlmock.New()
+			db, err := sql.Open("sqlite3", ":memory:")
 			require.NoError(t, err)
 			defer db.Close()
+			db.SetMaxOpenConns(1)
+			tt.setup(t, db)
 
 			storage := sqlite.New(db, 10)
-			tt.mock(dbMock)
-
-			err = storage.Prepare()
-			tt.wantErr(t, err)
+			require.NoError(t, storage.Prepare())
 
-			require.NoError(t, dbMock.ExpectationsWereMet())
+			tasks, err := storage.ReadGroup(context.Background(), models.TaskFilter{})
+			require.NoError(t, err)
+			assert.Len(t, tasks, tt.wantTasks)
 		})
 	}
 }
@@ -86,14 +85,12 @@ func TestTaskStorage_Create(t *testing.T) {
 		title   string = "Test title"
 		comment string = "Test comment"
 		repeat  string = "Test repeat"
+		anchor  string = "completion"
 	)
 
 	type args struct {
-		ctx     context.Context
-		date    string
-		title   string
-		comment string
-		repeat  string
+		ctx  context.Context
+		task models.Task
 	}
 
 	tests := []struct {
@@ -106,10 +103,10 @@ func TestTaskStorage_Create(t *testing.T) {
 		{
 			name: "successful creation",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("INSERT INTO scheduler (date, title, comment, repeat) VALUES (?, ?, ?, ?)")
-				dbMock.ExpectExec(query).WithArgs(date, title, comment, repeat).WillReturnResult(sqlmock.NewResult(id, 1))
+				query := regexp.QuoteMeta("INSERT INTO scheduler (date, title, comment, repeat, anchor, exdates, priority, status, project_id, parent_id, time, reminders, owner_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+				dbMock.ExpectExec(query).WithArgs(date, title, comment, repeat, anchor, "20250212,20250219", 2, "blocked", 4, 0, "14:30", "15,60", 0).WillReturnResult(sqlmock.NewResult(id, 1))
 			},
-			args: args{context.Background(), date, title, comment, repeat},
+			args: args{context.Background(), models.Task{Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: anchor, ExDates: []string{"20250212", "20250219"}, Priority: 2, Status: "blocked", ProjectID: 4, Time: "14:30", Reminders: []int{15, 60}}},
 			wantID: func(tt require.TestingT, got interface{}, _ ...interface{}) {
 				gottenID, ok := got.(int64)
 				require.True(t, ok)
@@ -117,13 +114,25 @@ func TestTaskStorage_Create(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "creation on behalf of a user",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				query := regexp.QuoteMeta("INSERT INTO scheduler (date, title, comment, repeat, anchor, exdates, priority, status, project_id, parent_id, time, reminders, owner_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+				dbMock.ExpectExec(query).WithArgs(date, title, "", "", "", "", 0, "", 0, 0, "", "", 3).WillReturnResult(sqlmock.NewResult(id, 1))
+			},
+			args: args{identity.WithUser(context.Background(), identity.User{ID: 3, Name: "alice"}), models.Task{Date: date, Title: title}},
+			wantID: func(tt require.TestingT, got interface{}, _ ...interface{}) {
+				assert.Equal(t, id, got)
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "database error",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("INSERT INTO scheduler (date, title, comment, repeat) VALUES (?, ?, ?, ?)")
-				dbMock.ExpectExec(query).WithArgs(date, title, comment, repeat).WillReturnError(errors.New("database error"))
+				query := regexp.QuoteMeta("INSERT INTO scheduler (date, title, comment, repeat, anchor, exdates, priority, status, project_id, parent_id, time, reminders, owner_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+				dbMock.ExpectExec(query).WithArgs(date, title, comment, repeat, anchor, "20250212,20250219", 2, "blocked", 4, 0, "14:30", "15,60", 0).WillReturnError(errors.New("database error"))
 			},
-			args: args{context.Background(), date, title, comment, repeat},
+			args: args{context.Background(), models.Task{Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: anchor, ExDates: []string{"20250212", "20250219"}, Priority: 2, Status: "blocked", ProjectID: 4, Time: "14:30", Reminders: []int{15, 60}}},
 			wantID: func(tt require.TestingT, got interface{}, _ ...interface{}) {
 				gottenID, ok := got.(int64)
 				require.True(t, ok)
@@ -145,7 +154,7 @@ func TestTaskStorage_Create(t *testing.T) {
 			storage := sqlite.New(db, 3)
 			tt.mocks(dbMock)
 
-			id, err := storage.Create(tt.args.ctx, tt.args.date, tt.args.title, tt.args.comment, tt.args.repeat)
+			id, err := storage.Create(tt.args.ctx, tt.args.task)
 			tt.wantID(t, id)
 			tt.wantErr(t, err)
 
@@ -180,9 +189,9 @@ func TestTaskStorage_Read(t *testing.T) {
 		{
 			name: "successful reading",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat"}).
-					AddRow(id, date, title, comment, repeat)
-				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat FROM scheduler WHERE id = ?`).
+				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"}).
+					AddRow(id, date, title, comment, repeat, "completion", "20250211,20250218", 2, 3, "todo", 0, 0, "", "", 4, nil, "work,home", "12,4", "15")
+				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, \(SELECT group_concat\(tags\.name, ','\) FROM task_tags JOIN tags ON tags\.id = task_tags\.tag_id WHERE task_tags\.task_id = scheduler\.id\) AS tags, \(SELECT group_concat\(dependencies\.blocker_id, ','\) FROM dependencies JOIN scheduler AS blocker ON blocker\.id = dependencies\.blocker_id WHERE dependencies\.task_id = scheduler\.id AND blocker\.deleted_at IS NULL\) AS blocked_by, \(SELECT group_concat\(dependencies\.task_id, ','\) FROM dependencies JOIN scheduler AS blocked ON blocked\.id = dependencies\.task_id WHERE dependencies\.blocker_id = scheduler\.id AND blocked\.deleted_at IS NULL\) AS blocking FROM scheduler WHERE id = \? AND deleted_at IS NULL`).
 					WithArgs(id).WillReturnRows(rows)
 			},
 			args: args{
@@ -197,13 +206,22 @@ func TestTaskStorage_Read(t *testing.T) {
 				assert.Equal(t, title, task.Title, i...)
 				assert.Equal(t, comment, task.Comment, i...)
 				assert.Equal(t, repeat, task.Repeat, i...)
+				assert.Equal(t, "completion", task.Anchor, i...)
+				assert.Equal(t, []string{"20250211", "20250218"}, task.ExDates, i...)
+				assert.Equal(t, 2, task.Occurrence, i...)
+				assert.Equal(t, 3, task.Priority, i...)
+				assert.Equal(t, "todo", task.Status, i...)
+				assert.Equal(t, int64(4), task.Version, i...)
+				assert.Equal(t, []string{"home", "work"}, task.Tags, i...)
+				assert.Equal(t, []int64{4, 12}, task.BlockedBy, i...)
+				assert.Equal(t, []int64{15}, task.Blocking, i...)
 			},
 			wantErr: require.NoError,
 		},
 		{
 			name: "no rows",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat FROM scheduler WHERE id = ?`).
+				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, \(SELECT group_concat\(tags\.name, ','\) FROM task_tags JOIN tags ON tags\.id = task_tags\.tag_id WHERE task_tags\.task_id = scheduler\.id\) AS tags, \(SELECT group_concat\(dependencies\.blocker_id, ','\) FROM dependencies JOIN scheduler AS blocker ON blocker\.id = dependencies\.blocker_id WHERE dependencies\.task_id = scheduler\.id AND blocker\.deleted_at IS NULL\) AS blocked_by, \(SELECT group_concat\(dependencies\.task_id, ','\) FROM dependencies JOIN scheduler AS blocked ON blocked\.id = dependencies\.task_id WHERE dependencies\.blocker_id = scheduler\.id AND blocked\.deleted_at IS NULL\) AS blocking FROM scheduler WHERE id = \? AND deleted_at IS NULL`).
 					WithArgs(id).WillReturnError(sql.ErrNoRows)
 			},
 			args: args{
@@ -215,13 +233,32 @@ func TestTaskStorage_Read(t *testing.T) {
 				require.True(t, ok)
 				require.Equal(t, models.Task{}, task)
 			},
-			wantErr: require.NoError,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+			},
+		},
+		{
+			name: "task of another user",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(`FROM scheduler WHERE id = \? AND deleted_at IS NULL AND owner_id = \?`).
+					WithArgs(id, 3).WillReturnError(sql.ErrNoRows)
+			},
+			args: args{
+				ctx: identity.WithUser(context.Background(), identity.User{ID: 3, Name: "alice"}),
+				id:  id,
+			},
+			wantTask: func(tt require.TestingT, got interface{}, i ...interface{}) {
+				require.Equal(t, models.Task{}, got)
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+			},
 		},
 		{
 			name: "database error",
 			mocks: func(dbMock sqlmock.Sqlmock) {
 				dbMock.
-					ExpectQuery(`SELECT id, date, title, comment, repeat FROM scheduler WHERE id = ?`).
+					ExpectQuery(`SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, \(SELECT group_concat\(tags\.name, ','\) FROM task_tags JOIN tags ON tags\.id = task_tags\.tag_id WHERE task_tags\.task_id = scheduler\.id\) AS tags, \(SELECT group_concat\(dependencies\.blocker_id, ','\) FROM dependencies JOIN scheduler AS blocker ON blocker\.id = dependencies\.blocker_id WHERE dependencies\.task_id = scheduler\.id AND blocker\.deleted_at IS NULL\) AS blocked_by, \(SELECT group_concat\(dependencies\.task_id, ','\) FROM dependencies JOIN scheduler AS blocked ON blocked\.id = dependencies\.task_id WHERE dependencies\.blocker_id = scheduler\.id AND blocked\.deleted_at IS NULL\) AS blocking FROM scheduler WHERE id = \? AND deleted_at IS NULL`).
 					WithArgs(id).
 					WillReturnError(errors.New("database error"))
 			},
@@ -276,11 +313,11 @@ func TestTaskStorage_ReadGroup(t *testing.T) {
 		{
 			name: "successful reading",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat"}).
-					AddRow(1, "20240203", "Test title task 1", "Comment for task 1", "d 7").
-					AddRow(2, "20240203", "Test title task 2", "Comment for task 2", "d 7").
-					AddRow(3, "20240203", "Test title task 3", "Comment for task 3", "d 7")
-				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat FROM scheduler ORDER BY date LIMIT ?`).
+				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"}).
+					AddRow(1, "20240203", "Test title task 1", "Comment for task 1", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil).
+					AddRow(2, "20240203", "Test title task 2", "Comment for task 2", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil).
+					AddRow(3, "20240203", "Test title task 3", "Comment for task 3", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil)
+				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, \(SELECT group_concat\(tags\.name, ','\) FROM task_tags JOIN tags ON tags\.id = task_tags\.tag_id WHERE task_tags\.task_id = scheduler\.id\) AS tags, \(SELECT group_concat\(dependencies\.blocker_id, ','\) FROM dependencies JOIN scheduler AS blocker ON blocker\.id = dependencies\.blocker_id WHERE dependencies\.task_id = scheduler\.id AND blocker\.deleted_at IS NULL\) AS blocked_by, \(SELECT group_concat\(dependencies\.task_id, ','\) FROM dependencies JOIN scheduler AS blocked ON blocked\.id = dependencies\.task_id WHERE dependencies\.blocker_id = scheduler\.id AND blocked\.deleted_at IS NULL\) AS blocking FROM scheduler WHERE deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?`).
 					WithArgs(3).
 					WillReturnRows(rows)
 			},
@@ -315,8 +352,8 @@ func TestTaskStorage_ReadGroup(t *testing.T) {
 		{
 			name: "no rows",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat"})
-				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat FROM scheduler ORDER BY date LIMIT ?`).
+				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"})
+				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, \(SELECT group_concat\(tags\.name, ','\) FROM task_tags JOIN tags ON tags\.id = task_tags\.tag_id WHERE task_tags\.task_id = scheduler\.id\) AS tags, \(SELECT group_concat\(dependencies\.blocker_id, ','\) FROM dependencies JOIN scheduler AS blocker ON blocker\.id = dependencies\.blocker_id WHERE dependencies\.task_id = scheduler\.id AND blocker\.deleted_at IS NULL\) AS blocked_by, \(SELECT group_concat\(dependencies\.task_id, ','\) FROM dependencies JOIN scheduler AS blocked ON blocked\.id = dependencies\.task_id WHERE dependencies\.blocker_id = scheduler\.id AND blocked\.deleted_at IS NULL\) AS blocking FROM scheduler WHERE deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?`).
 					WithArgs(3).
 					WillReturnRows(rows)
 			},
@@ -333,7 +370,7 @@ func TestTaskStorage_ReadGroup(t *testing.T) {
 		{
 			name: "database error",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat FROM scheduler ORDER BY date LIMIT ?`).
+				dbMock.ExpectQuery(`SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, \(SELECT group_concat\(tags\.name, ','\) FROM task_tags JOIN tags ON tags\.id = task_tags\.tag_id WHERE task_tags\.task_id = scheduler\.id\) AS tags, \(SELECT group_concat\(dependencies\.blocker_id, ','\) FROM dependencies JOIN scheduler AS blocker ON blocker\.id = dependencies\.blocker_id WHERE dependencies\.task_id = scheduler\.id AND blocker\.deleted_at IS NULL\) AS blocked_by, \(SELECT group_concat\(dependencies\.task_id, ','\) FROM dependencies JOIN scheduler AS blocked ON blocked\.id = dependencies\.task_id WHERE dependencies\.blocker_id = scheduler\.id AND blocked\.deleted_at IS NULL\) AS blocking FROM scheduler WHERE deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?`).
 					WithArgs(3).
 					WillReturnError(errors.New("database error"))
 			},
@@ -361,7 +398,7 @@ func TestTaskStorage_ReadGroup(t *testing.T) {
 			storage := sqlite.New(db, 3)
 			tt.mocks(dbMock)
 
-			tasks, err := storage.ReadGroup(tt.args.ctx)
+			tasks, err := storage.ReadGroup(tt.args.ctx, models.TaskFilter{})
 			tt.wantErr(t, err)
 			tt.wantTasks(t, tasks)
 
@@ -390,15 +427,15 @@ func TestTaskStorage_ReadByDate(t *testing.T) {
 		{
 			name: "successful reading",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat"}).
-					AddRow(1, "20240203", "Test title task 1", "Comment for task 1", "d 7").
-					AddRow(2, "20240203", "Test title task 2", "Comment for task 2", "d 7").
-					AddRow(3, "20240203", "Test title task 3", "Comment for task 3", "d 7")
-				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat FROM scheduler WHERE date = ? LIMIT ?")
+				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"}).
+					AddRow(1, "20240203", "Test title task 1", "Comment for task 1", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil).
+					AddRow(2, "20240203", "Test title task 2", "Comment for task 2", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil).
+					AddRow(3, "20240203", "Test title task 3", "Comment for task 3", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil)
+				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE date = ? AND deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?")
 				dbMock.ExpectQuery(query).
 					WithArgs(date, 3).
 					WillReturnRows(rows)
-			}, // SELECT id, date, title, comment, repeat FROM scheduler WHERE date = ? LIMIT ?
+			}, // SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE date = ? AND deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?
 			args: args{
 				ctx:  context.Background(),
 				date: date,
@@ -431,8 +468,8 @@ func TestTaskStorage_ReadByDate(t *testing.T) {
 		{
 			name: "no rows",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat"})
-				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat FROM scheduler WHERE date = ? LIMIT ?")
+				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"})
+				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE date = ? AND deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?")
 				dbMock.ExpectQuery(query).
 					WithArgs(date, 3).
 					WillReturnRows(rows)
@@ -451,7 +488,7 @@ func TestTaskStorage_ReadByDate(t *testing.T) {
 		{
 			name: "database error",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat FROM scheduler WHERE date = ? LIMIT ?")
+				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE date = ? AND deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?")
 				dbMock.ExpectQuery(query).
 					WithArgs(date, 3).
 					WillReturnError(errors.New("database error"))
@@ -481,7 +518,7 @@ func TestTaskStorage_ReadByDate(t *testing.T) {
 			storage := sqlite.New(db, 3)
 			tt.mocks(dbMock)
 
-			tasks, err := storage.ReadByDate(tt.args.ctx, tt.args.date)
+			tasks, err := storage.ReadByDate(tt.args.ctx, tt.args.date, models.TaskFilter{})
 			tt.wantErr(t, err)
 			tt.wantTasks(t, tasks)
 
@@ -510,15 +547,15 @@ func TestTaskStorage_ReadByPayload(t *testing.T) {
 		{
 			name: "successful reading",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat"}).
-					AddRow(1, "20240203", "Test title task 1", "Comment for task 1", "d 7").
-					AddRow(2, "20240203", "Test title task 2", "Comment for task 2", "d 7").
-					AddRow(3, "20240203", "Test title task 3", "Comment for task 3", "d 7")
-				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat FROM scheduler WHERE title LIKE ? OR comment LIKE ? ORDER BY date LIMIT ?")
+				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"}).
+					AddRow(1, "20240203", "Test title task 1", "Comment for task 1", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil).
+					AddRow(2, "20240203", "Test title task 2", "Comment for task 2", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil).
+					AddRow(3, "20240203", "Test title task 3", "Comment for task 3", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, nil, nil, nil, nil)
+				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE (title LIKE ? OR comment LIKE ?) AND deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?")
 				dbMock.ExpectQuery(query).
 					WithArgs("%"+payload+"%", "%"+payload+"%", 3).
 					WillReturnRows(rows)
-			}, // SELECT id, date, title, comment, repeat FROM scheduler WHERE date = ? LIMIT ?
+			}, // SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE date = ? AND deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?
 			args: args{
 				ctx:     context.Background(),
 				payload: payload,
@@ -551,8 +588,8 @@ func TestTaskStorage_ReadByPayload(t *testing.T) {
 		{
 			name: "no rows",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat"})
-				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat FROM scheduler WHERE title LIKE ? OR comment LIKE ? ORDER BY date LIMIT ?")
+				rows := sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"})
+				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE (title LIKE ? OR comment LIKE ?) AND deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?")
 				dbMock.ExpectQuery(query).
 					WithArgs("%"+payload+"%", "%"+payload+"%", 3).
 					WillReturnRows(rows)
@@ -571,7 +608,7 @@ func TestTaskStorage_ReadByPayload(t *testing.T) {
 		{
 			name: "database error",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat FROM scheduler WHERE title LIKE ? OR comment LIKE ? ORDER BY date LIMIT ?")
+				query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE (title LIKE ? OR comment LIKE ?) AND deleted_at IS NULL AND parent_id = 0 ORDER BY date LIMIT ?")
 				dbMock.ExpectQuery(query).
 					WithArgs("%"+payload+"%", "%"+payload+"%", 3).
 					WillReturnError(errors.New("database error"))
@@ -601,7 +638,7 @@ func TestTaskStorage_ReadByPayload(t *testing.T) {
 			storage := sqlite.New(db, 3)
 			tt.mocks(dbMock)
 
-			tasks, err := storage.ReadByPayload(tt.args.ctx, tt.args.payload)
+			tasks, err := storage.ReadByPayload(tt.args.ctx, tt.args.payload, models.TaskFilter{})
 			tt.wantErr(t, err)
 			tt.wantTasks(t, tasks)
 
@@ -635,19 +672,24 @@ func TestTaskStorage_Update(t *testing.T) {
 		{
 			name: "successful update",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, title = ?, comment = ?, repeat = ? WHERE id = ?")
+				query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, title = ?, comment = ?, repeat = ?, anchor = ?, exdates = ?, occurrence = ?, priority = ?, status = ?, project_id = ?, time = ?, reminders = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL")
 				dbMock.ExpectExec(query).
-					WithArgs(date, title, comment, repeat, id).
+					WithArgs(date, title, comment, repeat, "due", "", 3, 2, "blocked", 4, "", "", id).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			args: args{
 				ctx: context.Background(),
 				task: &models.Task{
-					ID:      id,
-					Date:    date,
-					Title:   title,
-					Comment: comment,
-					Repeat:  repeat,
+					ID:         id,
+					Date:       date,
+					Title:      title,
+					Comment:    comment,
+					Repeat:     repeat,
+					Anchor:     "due",
+					Occurrence: 3,
+					Priority:   2,
+					Status:     "blocked",
+					ProjectID:  4,
 				},
 			},
 			wantErr: require.NoError,
@@ -655,39 +697,104 @@ func TestTaskStorage_Update(t *testing.T) {
 		{
 			name: "no rows affected",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, title = ?, comment = ?, repeat = ? WHERE id = ?")
+				query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, title = ?, comment = ?, repeat = ?, anchor = ?, exdates = ?, occurrence = ?, priority = ?, status = ?, project_id = ?, time = ?, reminders = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL")
 				dbMock.ExpectExec(query).
-					WithArgs(date, title, comment, repeat, id).
+					WithArgs(date, title, comment, repeat, "due", "", 3, 2, "blocked", 4, "", "", id).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			args: args{
 				ctx: context.Background(),
 				task: &models.Task{
-					ID:      id,
-					Date:    date,
-					Title:   title,
-					Comment: comment,
-					Repeat:  repeat,
+					ID:         id,
+					Date:       date,
+					Title:      title,
+					Comment:    comment,
+					Repeat:     repeat,
+					Anchor:     "due",
+					Occurrence: 3,
+					Priority:   2,
+					Status:     "blocked",
+					ProjectID:  4,
 				},
 			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+			},
+		},
+		{
+			name: "successful update of a version",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, title = ?, comment = ?, repeat = ?, anchor = ?, exdates = ?, occurrence = ?, priority = ?, status = ?, project_id = ?, time = ?, reminders = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL AND version = ?")
+				dbMock.ExpectExec(query).
+					WithArgs(date, title, comment, repeat, "due", "", 1, 3, "todo", 0, "", "", id, 5).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			args: args{
+				ctx:  context.Background(),
+				task: &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: "due", Occurrence: 1, Priority: 3, Status: "todo", Version: 5},
+			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "version changed by another writer",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, title = ?, comment = ?, repeat = ?, anchor = ?, exdates = ?, occurrence = ?, priority = ?, status = ?, project_id = ?, time = ?, reminders = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL AND version = ?")
+				dbMock.ExpectExec(query).
+					WithArgs(date, title, comment, repeat, "due", "", 1, 3, "todo", 0, "", "", id, 5).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				dbMock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS (SELECT 1 FROM scheduler WHERE id = ? AND deleted_at IS NULL)")).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			args: args{
+				ctx:  context.Background(),
+				task: &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: "due", Occurrence: 1, Priority: 3, Status: "todo", Version: 5},
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrPrecondition, i...)
+				require.EqualError(tt, err, "task 1 was changed in the meantime: version 5 is not the current one", i...)
+			},
+		},
+		{
+			name: "versioned task not found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, title = ?, comment = ?, repeat = ?, anchor = ?, exdates = ?, occurrence = ?, priority = ?, status = ?, project_id = ?, time = ?, reminders = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL AND version = ? AND owner_id = ?")
+				dbMock.ExpectExec(query).
+					WithArgs(date, title, comment, repeat, "due", "", 1, 3, "todo", 0, "", "", id, 5, 3).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				dbMock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS (SELECT 1 FROM scheduler WHERE id = ? AND deleted_at IS NULL AND owner_id = ?)")).
+					WithArgs(id, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+			args: args{
+				ctx:  identity.WithUser(context.Background(), identity.User{ID: 3, Name: "alice"}),
+				task: &models.Task{ID: id, Date: date, Title: title, Comment: comment, Repeat: repeat, Anchor: "due", Occurrence: 1, Priority: 3, Status: "todo", Version: 5},
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+			},
+		},
 		{
 			name: "database error",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, title = ?, comment = ?, repeat = ? WHERE id = ?")
+				query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, title = ?, comment = ?, repeat = ?, anchor = ?, exdates = ?, occurrence = ?, priority = ?, status = ?, project_id = ?, time = ?, reminders = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL")
 				dbMock.ExpectExec(query).
-					WithArgs(date, title, comment, repeat, id).
+					WithArgs(date, title, comment, repeat, "due", "", 3, 2, "blocked", 4, "", "", id).
 					WillReturnError(errors.New("database error"))
 			},
 			args: args{
 				ctx: context.Background(),
 				task: &models.Task{
-					ID:      id,
-					Date:    date,
-					Title:   title,
-					Comment: comment,
-					Repeat:  repeat,
+					ID:         id,
+					Date:       date,
+					Title:      title,
+					Comment:    comment,
+					Repeat:     repeat,
+					Anchor:     "due",
+					Occurrence: 3,
+					Priority:   2,
+					Status:     "blocked",
+					ProjectID:  4,
 				},
 			},
 			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
@@ -745,9 +852,9 @@ func TestTaskStorage_Delete(t *testing.T) {
 		{
 			name: "successful deletion",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("DELETE FROM scheduler WHERE id = ?")
+				query := regexp.QuoteMeta("UPDATE scheduler SET deleted_at = strftime('%Y-%m-%dT%H:%M:%SZ', 'now') WHERE deleted_at IS NULL AND (id = ? OR parent_id IN (SELECT id FROM scheduler WHERE id = ? AND deleted_at IS NULL))")
 				dbMock.ExpectExec(query).
-					WithArgs(id).
+					WithArgs(id, id).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			args: args{
@@ -756,26 +863,42 @@ func TestTaskStorage_Delete(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "successful deletion with subtasks",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				query := regexp.QuoteMeta("UPDATE scheduler SET deleted_at = strftime('%Y-%m-%dT%H:%M:%SZ', 'now') WHERE deleted_at IS NULL AND (id = ? OR parent_id IN (SELECT id FROM scheduler WHERE id = ? AND deleted_at IS NULL))")
+				dbMock.ExpectExec(query).
+					WithArgs(id, id).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  id,
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "no rows affected",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("DELETE FROM scheduler WHERE id = ?")
+				query := regexp.QuoteMeta("UPDATE scheduler SET deleted_at = strftime('%Y-%m-%dT%H:%M:%SZ', 'now') WHERE deleted_at IS NULL AND (id = ? OR parent_id IN (SELECT id FROM scheduler WHERE id = ? AND deleted_at IS NULL))")
 				dbMock.ExpectExec(query).
-					WithArgs(id).
+					WithArgs(id, id).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			args: args{
 				ctx: context.Background(),
 				id:  id,
 			},
-			wantErr: require.NoError,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+			},
 		},
 		{
 			name: "database error",
 			mocks: func(dbMock sqlmock.Sqlmock) {
-				query := regexp.QuoteMeta("DELETE FROM scheduler WHERE id = ?")
+				query := regexp.QuoteMeta("UPDATE scheduler SET deleted_at = strftime('%Y-%m-%dT%H:%M:%SZ', 'now') WHERE deleted_at IS NULL AND (id = ? OR parent_id IN (SELECT id FROM scheduler WHERE id = ? AND deleted_at IS NULL))")
 				dbMock.ExpectExec(query).
-					WithArgs(id).
+					WithArgs(id, id).
 					WillReturnError(errors.New("database error"))
 			},
 			args: args{
@@ -805,3 +928,173 @@ func TestTaskStorage_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskStorage_InTx(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		fn      func(storage sqlite.TaskStorage) func(ctx context.Context) error
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "commit",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(regexp.QuoteMeta("UPDATE scheduler SET deleted_at = strftime('%Y-%m-%dT%H:%M:%SZ', 'now') WHERE deleted_at IS NULL AND (id = ? OR parent_id IN (SELECT id FROM scheduler WHERE id = ? AND deleted_at IS NULL))")).
+					WithArgs(1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				dbMock.ExpectCommit()
+			},
+			fn: func(storage sqlite.TaskStorage) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					return storage.Delete(ctx, 1)
+				}
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "rollback",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(regexp.QuoteMeta("UPDATE scheduler SET deleted_at = strftime('%Y-%m-%dT%H:%M:%SZ', 'now') WHERE deleted_at IS NULL AND (id = ? OR parent_id IN (SELECT id FROM scheduler WHERE id = ? AND deleted_at IS NULL))")).
+					WithArgs(1, 1).
+					WillReturnError(errors.New("database error"))
+				dbMock.ExpectRollback()
+			},
+			fn: func(storage sqlite.TaskStorage) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					return storage.Delete(ctx, 1)
+				}
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to delete task: database error", i...)
+			},
+		},
+		{
+			name: "nested transaction joins the outer one",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectCommit()
+			},
+			fn: func(storage sqlite.TaskStorage) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					return storage.InTx(ctx, func(ctx context.Context) error { return nil })
+				}
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "begin error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin().WillReturnError(errors.New("database error"))
+			},
+			fn: func(storage sqlite.TaskStorage) func(ctx context.Context) error {
+				return func(ctx context.Context) error { return nil }
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot begin transaction: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.InTx(context.Background(), tt.fn(storage))
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_ReadGroup_Filter(t *testing.T) {
+	t.Parallel()
+
+	const selectTasks = "SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler "
+
+	tests := []struct {
+		name      string
+		user      identity.User
+		filter    models.TaskFilter
+		wantQuery string
+		wantArgs  []driver.Value
+	}{
+		{
+			name:      "by priority",
+			filter:    models.TaskFilter{Priority: 1},
+			wantQuery: selectTasks + "WHERE deleted_at IS NULL AND parent_id = 0 AND priority = ? ORDER BY date LIMIT ?",
+			wantArgs:  []driver.Value{1, 3},
+		},
+		{
+			name:      "by status sorted by priority",
+			filter:    models.TaskFilter{Status: "in_progress", Sort: "priority"},
+			wantQuery: selectTasks + "WHERE deleted_at IS NULL AND parent_id = 0 AND status = ? ORDER BY priority, date LIMIT ?",
+			wantArgs:  []driver.Value{"in_progress", 3},
+		},
+		{
+			name:      "sorted by status",
+			filter:    models.TaskFilter{Sort: "status"},
+			wantQuery: selectTasks + "WHERE deleted_at IS NULL AND parent_id = 0 ORDER BY CASE status WHEN 'todo' THEN 1 WHEN 'in_progress' THEN 2 WHEN 'blocked' THEN 3 ELSE 4 END, date LIMIT ?",
+			wantArgs:  []driver.Value{3},
+		},
+		{
+			name:      "by project",
+			filter:    models.TaskFilter{ProjectID: 4},
+			wantQuery: selectTasks + "WHERE deleted_at IS NULL AND parent_id = 0 AND project_id = ? ORDER BY date LIMIT ?",
+			wantArgs:  []driver.Value{4, 3},
+		},
+		{
+			name:      "with any of the tags",
+			filter:    models.TaskFilter{Tags: []string{"home", "work"}},
+			wantQuery: selectTasks + "WHERE deleted_at IS NULL AND parent_id = 0 AND id IN (SELECT task_tags.task_id FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE tags.name IN (?, ?)) ORDER BY date LIMIT ?",
+			wantArgs:  []driver.Value{"home", "work", 3},
+		},
+		{
+			name:      "with all of the tags",
+			filter:    models.TaskFilter{Tags: []string{"home", "work"}, AllTags: true},
+			wantQuery: selectTasks + "WHERE deleted_at IS NULL AND parent_id = 0 AND id IN (SELECT task_tags.task_id FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE tags.name IN (?, ?) GROUP BY task_tags.task_id HAVING COUNT(*) = ?) ORDER BY date LIMIT ?",
+			wantArgs:  []driver.Value{"home", "work", 2, 3},
+		},
+		{
+			name:      "tasks of a user",
+			user:      identity.User{ID: 3, Name: "alice"},
+			filter:    models.TaskFilter{Status: "todo"},
+			wantQuery: selectTasks + "WHERE deleted_at IS NULL AND parent_id = 0 AND owner_id = ? AND status = ? ORDER BY date LIMIT ?",
+			wantArgs:  []driver.Value{3, "todo", 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			dbMock.ExpectQuery(regexp.QuoteMeta(tt.wantQuery)).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"}))
+
+			ctx := context.Background()
+			if tt.user.ID != 0 {
+				ctx = identity.WithUser(ctx, tt.user)
+			}
+
+			_, err = storage.ReadGroup(ctx, tt.filter)
+			require.NoError(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
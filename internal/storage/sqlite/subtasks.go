@@ -0,0 +1,33 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+// ReadChildren retrieves the subtasks of a task outside the trash in the order they were added.
+//
+// Returns:
+// - []models.Task: A slice of subtasks.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) ReadChildren(ctx context.Context, parentID int64) ([]models.Task, error) {
+	owner, ownerArgs := owned(ctx, "owner_id")
+	query := `SELECT ` + taskColumns + ` FROM scheduler WHERE parent_id = ? AND deleted_at IS NULL` + owner + ` ORDER BY id`
+	return s.queryTasks(ctx, query, append([]any{parentID}, ownerArgs...)...)
+}
+
+// ResetChildren moves the subtasks of a task outside the trash to the given date and back to the todo status.
+// Their versions are bumped, so that an If-Match issued before the reset no longer applies.
+//
+// Returns:
+// - error: Wrapped error if the update fails.
+func (s TaskStorage) ResetChildren(ctx context.Context, parentID int64, date string) error {
+	owner, ownerArgs := owned(ctx, "owner_id")
+	query := `UPDATE scheduler SET date = ?, status = ?, version = version + 1 WHERE parent_id = ? AND deleted_at IS NULL` + owner
+	if _, err := s.conn(ctx).ExecContext(ctx, query, append([]any{date, models.StatusTodo, parentID}, ownerArgs...)...); err != nil {
+		return fmt.Errorf("failed to reset subtasks: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_ReadChildren(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"}
+	query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE parent_id = ? AND deleted_at IS NULL ORDER BY id")
+
+	tests := []struct {
+		name      string
+		mocks     func(dbMock sqlmock.Sqlmock)
+		wantTasks []models.Task
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "subtasks",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(8, "20240203", "Buy paint", "", "", "due", "", 1, 3, "done", 2, 7, "", "", 1, nil, nil, nil, nil).
+					AddRow(9, "20240203", "Paint the fence", "", "", "due", "", 1, 3, "todo", 2, 7, "", "", 1, nil, nil, nil, nil)
+				dbMock.ExpectQuery(query).WithArgs(7).WillReturnRows(rows)
+			},
+			wantTasks: []models.Task{
+				{ID: 8, Date: "20240203", Title: "Buy paint", Anchor: "due", Occurrence: 1, Priority: 3, Status: "done", Version: 1, ProjectID: 2, ParentID: 7},
+				{ID: 9, Date: "20240203", Title: "Paint the fence", Anchor: "due", Occurrence: 1, Priority: 3, Status: "todo", Version: 1, ProjectID: 2, ParentID: 7},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(7).WillReturnError(errors.New("database error"))
+			},
+			wantTasks: []models.Task{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			tasks, err := storage.ReadChildren(context.Background(), 7)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantTasks, tasks)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_ResetChildren(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("UPDATE scheduler SET date = ?, status = ?, version = version + 1 WHERE parent_id = ? AND deleted_at IS NULL")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "subtasks reset",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("20240210", "todo", 7).WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("20240210", "todo", 7).WillReturnError(errors.New("database error"))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to reset subtasks: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.ResetChildren(context.Background(), 7, "20240210")
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
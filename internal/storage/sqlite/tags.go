@@ -0,0 +1,148 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+// SetTags replaces the tags of a task. Tags which the owner of the task does not have yet are created.
+//
+// Returns:
+// - error: Wrapped error if any statement fails. No tags are changed in that case.
+func (s TaskStorage) SetTags(ctx context.Context, taskID int64, names []string) error {
+	return s.InTx(ctx, func(ctx context.Context) error {
+		if _, err := s.conn(ctx).ExecContext(ctx, `DELETE FROM task_tags WHERE task_id = ?`, taskID); err != nil {
+			return fmt.Errorf("failed to set tags: %w", err)
+		}
+
+		for _, name := range names {
+			query := `INSERT INTO tags (owner_id, name) VALUES (` + taskOwner + `, ?) ON CONFLICT(owner_id, name) DO NOTHING`
+			if _, err := s.conn(ctx).ExecContext(ctx, query, taskID, name); err != nil {
+				return fmt.Errorf("failed to set tags: %w", err)
+			}
+
+			query = `INSERT INTO task_tags (task_id, tag_id) SELECT ?, id FROM tags WHERE name = ? AND owner_id = ` + taskOwner
+			if _, err := s.conn(ctx).ExecContext(ctx, query, taskID, name, taskID); err != nil {
+				return fmt.Errorf("failed to set tags: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// ReadTags retrieves all tags in alphabetical order with the number of tasks outside the trash carrying them.
+//
+// Returns:
+// - []models.Tag: A slice of tags.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) ReadTags(ctx context.Context) ([]models.Tag, error) {
+	query := `
+		SELECT tags.id, tags.name, COUNT(scheduler.id)
+		FROM tags
+		LEFT JOIN task_tags ON task_tags.tag_id = tags.id
+		LEFT JOIN scheduler ON scheduler.id = task_tags.task_id AND scheduler.deleted_at IS NULL
+	`
+	var args []any
+	if owner, ok := ownerID(ctx); ok {
+		query += ` WHERE tags.owner_id = ?`
+		args = append(args, owner)
+	}
+	query += ` GROUP BY tags.id ORDER BY tags.name`
+	rows, err := s.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return make([]models.Tag, 0), fmt.Errorf("cannot execute query: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make([]models.Tag, 0)
+	for rows.Next() {
+		var tag models.Tag
+		if err := rows.Scan(&tag.ID, &tag.Name, &tag.Tasks); err != nil {
+			return make([]models.Tag, 0), fmt.Errorf("cannot read row: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return make([]models.Tag, 0), fmt.Errorf("cannot read tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// TagID looks a tag up by its name.
+//
+// Returns:
+// - int64: ID of the tag, 0 if there is no tag with the name.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) TagID(ctx context.Context, name string) (int64, error) {
+	var id int64
+	owner, ownerArgs := owned(ctx, "owner_id")
+	err := s.conn(ctx).QueryRowContext(ctx, `SELECT id FROM tags WHERE name = ?`+owner, append([]any{name}, ownerArgs...)...).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("cannot read tag from database: %w", err)
+	}
+	return id, nil
+}
+
+// CreateTag adds a tag owned by the user carried by ctx which is not attached to any task yet.
+//
+// Returns:
+// - int64: ID of the created tag.
+// - error: Wrapped error if the insert fails.
+func (s TaskStorage) CreateTag(ctx context.Context, name string) (int64, error) {
+	owner, _ := ownerID(ctx)
+	result, err := s.conn(ctx).ExecContext(ctx, `INSERT INTO tags (owner_id, name) VALUES (?, ?)`, owner, name)
+	if err != nil {
+		return 0, fmt.Errorf("cannot insert tag in database: %w", err)
+	}
+
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("cannot take last insert id: %w", err)
+	}
+
+	return lastID, nil
+}
+
+// RenameTag changes the name of a tag. The new name must not be taken by another tag.
+//
+// Returns:
+// - error: Wrapped error if the update fails.
+func (s TaskStorage) RenameTag(ctx context.Context, id int64, name string) error {
+	owner, ownerArgs := owned(ctx, "owner_id")
+	_, err := s.conn(ctx).ExecContext(ctx, `UPDATE tags SET name = ? WHERE id = ?`+owner, append([]any{name, id}, ownerArgs...)...)
+	if err != nil {
+		return fmt.Errorf("failed to rename tag: %w", err)
+	}
+	return nil
+}
+
+// MergeTag moves the tasks of one tag to another and removes the first tag.
+//
+// Returns:
+// - error: Wrapped error if any statement fails. No tags are changed in that case.
+func (s TaskStorage) MergeTag(ctx context.Context, from, into int64) error {
+	return s.InTx(ctx, func(ctx context.Context) error {
+		for _, stmt := range []struct {
+			query string
+			args  []any
+		}{
+			{`INSERT OR IGNORE INTO task_tags (task_id, tag_id) SELECT task_id, ? FROM task_tags WHERE tag_id = ?`, []any{into, from}},
+			{`DELETE FROM task_tags WHERE tag_id = ?`, []any{from}},
+			{`DELETE FROM tags WHERE id = ?`, []any{from}},
+		} {
+			if _, err := s.conn(ctx).ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+				return fmt.Errorf("failed to merge tags: %w", err)
+			}
+		}
+		return nil
+	})
+}
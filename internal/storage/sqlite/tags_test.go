@@ -0,0 +1,269 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_SetTags(t *testing.T) {
+	t.Parallel()
+
+	var (
+		clearQuery  = regexp.QuoteMeta("DELETE FROM task_tags WHERE task_id = ?")
+		createQuery = regexp.QuoteMeta("INSERT INTO tags (owner_id, name) VALUES (COALESCE((SELECT owner_id FROM scheduler WHERE id = ?), 0), ?) ON CONFLICT(owner_id, name) DO NOTHING")
+		attachQuery = regexp.QuoteMeta("INSERT INTO task_tags (task_id, tag_id) SELECT ?, id FROM tags WHERE name = ? AND owner_id = COALESCE((SELECT owner_id FROM scheduler WHERE id = ?), 0)")
+	)
+
+	tests := []struct {
+		name    string
+		tags    []string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "tags replaced",
+			tags: []string{"home", "work"},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(clearQuery).WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 1))
+				dbMock.ExpectExec(createQuery).WithArgs(7, "home").WillReturnResult(sqlmock.NewResult(1, 1))
+				dbMock.ExpectExec(attachQuery).WithArgs(7, "home", 7).WillReturnResult(sqlmock.NewResult(0, 1))
+				dbMock.ExpectExec(createQuery).WithArgs(7, "work").WillReturnResult(sqlmock.NewResult(0, 0))
+				dbMock.ExpectExec(attachQuery).WithArgs(7, "work", 7).WillReturnResult(sqlmock.NewResult(0, 1))
+				dbMock.ExpectCommit()
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "tags cleared",
+			tags: nil,
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(clearQuery).WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 2))
+				dbMock.ExpectCommit()
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			tags: []string{"home"},
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(clearQuery).WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 1))
+				dbMock.ExpectExec(createQuery).WithArgs(7, "home").WillReturnError(errors.New("database error"))
+				dbMock.ExpectRollback()
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to set tags: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.SetTags(context.Background(), 7, tt.tags)
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_ReadTags(t *testing.T) {
+	t.Parallel()
+
+	query := `SELECT tags\.id, tags\.name, COUNT\(scheduler\.id\)\s+FROM tags`
+
+	tests := []struct {
+		name     string
+		mocks    func(dbMock sqlmock.Sqlmock)
+		wantTags []models.Tag
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "tags with counts",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "tasks"}).
+					AddRow(2, "home", 3).
+					AddRow(1, "work", 0)
+				dbMock.ExpectQuery(query).WillReturnRows(rows)
+			},
+			wantTags: []models.Tag{{ID: 2, Name: "home", Tasks: 3}, {ID: 1, Name: "work", Tasks: 0}},
+			wantErr:  require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WillReturnError(errors.New("database error"))
+			},
+			wantTags: []models.Tag{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			tags, err := storage.ReadTags(context.Background())
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantTags, tags)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_TagID(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("SELECT id FROM tags WHERE name = ?")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantID  int64
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "tag found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs("home").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+			},
+			wantID:  2,
+			wantErr: require.NoError,
+		},
+		{
+			name: "tag not found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs("home").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			wantID:  0,
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs("home").WillReturnError(errors.New("database error"))
+			},
+			wantID: 0,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot read tag from database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			id, err := storage.TagID(context.Background(), "home")
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantID, id)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_RenameTag(t *testing.T) {
+	t.Parallel()
+
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dbMock.ExpectExec(regexp.QuoteMeta("UPDATE tags SET name = ? WHERE id = ?")).
+		WithArgs("house", 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = sqlite.New(db, 3).RenameTag(context.Background(), 2, "house")
+	require.NoError(t, err)
+
+	require.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestTaskStorage_MergeTag(t *testing.T) {
+	t.Parallel()
+
+	var (
+		moveQuery   = regexp.QuoteMeta("INSERT OR IGNORE INTO task_tags (task_id, tag_id) SELECT task_id, ? FROM task_tags WHERE tag_id = ?")
+		detachQuery = regexp.QuoteMeta("DELETE FROM task_tags WHERE tag_id = ?")
+		deleteQuery = regexp.QuoteMeta("DELETE FROM tags WHERE id = ?")
+	)
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "tags merged",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(moveQuery).WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 3))
+				dbMock.ExpectExec(detachQuery).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 3))
+				dbMock.ExpectExec(deleteQuery).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+				dbMock.ExpectCommit()
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(moveQuery).WithArgs(1, 2).WillReturnError(errors.New("database error"))
+				dbMock.ExpectRollback()
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to merge tags: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.MergeTag(context.Background(), 2, 1)
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// ReadTrash retrieves a limited number of trashed tasks, most recently deleted first.
+//
+// Returns:
+// - []models.Task: A slice of trashed tasks.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) ReadTrash(ctx context.Context) ([]models.Task, error) {
+	owner, args := owned(ctx, "owner_id")
+	query := `SELECT ` + taskColumns + ` FROM scheduler WHERE deleted_at IS NOT NULL` + owner + ` ORDER BY deleted_at DESC, id DESC LIMIT ?`
+	return s.queryTasks(ctx, query, append(args, s.Limit)...)
+}
+
+// Restore takes a task out of the trash together with the subtasks trashed along with it.
+// Subtasks deleted on their own before their parent stay in the trash.
+//
+// Returns:
+// - error: An error of the domain.ErrNotFound kind if there is no trashed task with the ID,
+// or a wrapped error if the update fails.
+func (s TaskStorage) Restore(ctx context.Context, id int64) error {
+	owner, ownerArgs := owned(ctx, "owner_id")
+	query := `
+		UPDATE scheduler
+		SET deleted_at = NULL
+		WHERE deleted_at IS NOT NULL AND (id = ? OR parent_id = ? AND deleted_at = (SELECT deleted_at FROM scheduler WHERE id = ?))` + owner
+	result, err := s.conn(ctx).ExecContext(ctx, query, append([]any{id, id, id}, ownerArgs...)...)
+	if err != nil {
+		return fmt.Errorf("failed to restore task: %w", err)
+	}
+
+	return expectAffected(result, domain.New(domain.ErrNotFound, fmt.Sprintf("task %d not found in the trash", id)))
+}
+
+// Purge permanently removes the tasks trashed before the given RFC 3339 time together with their subtasks, tags and dependencies.
+//
+// Returns:
+// - int64: Number of removed tasks.
+// - error: Wrapped error if the deletion fails.
+func (s TaskStorage) Purge(ctx context.Context, before string) (int64, error) {
+	const (
+		trashed   = `deleted_at IS NOT NULL AND deleted_at < ?`
+		purgeable = trashed + ` OR parent_id IN (SELECT id FROM scheduler WHERE ` + trashed + `)`
+	)
+
+	var purged int64
+	err := s.InTx(ctx, func(ctx context.Context) error {
+		query := `DELETE FROM task_tags WHERE task_id IN (SELECT id FROM scheduler WHERE ` + purgeable + `)`
+		if _, err := s.conn(ctx).ExecContext(ctx, query, before, before); err != nil {
+			return fmt.Errorf("failed to purge trash: %w", err)
+		}
+
+		query = `DELETE FROM dependencies WHERE task_id IN (SELECT id FROM scheduler WHERE ` + purgeable + `)` +
+			` OR blocker_id IN (SELECT id FROM scheduler WHERE ` + purgeable + `)`
+		if _, err := s.conn(ctx).ExecContext(ctx, query, before, before, before, before); err != nil {
+			return fmt.Errorf("failed to purge trash: %w", err)
+		}
+
+		query = `DELETE FROM scheduler WHERE ` + purgeable
+		result, err := s.conn(ctx).ExecContext(ctx, query, before, before)
+		if err != nil {
+			return fmt.Errorf("failed to purge trash: %w", err)
+		}
+
+		purged, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("cannot take affected rows: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,197 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStorage_ReadTrash(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "date", "title", "comment", "repeat", "anchor", "exdates", "occurrence", "priority", "status", "project_id", "parent_id", "time", "reminders", "version", "deleted_at", "tags", "blocked_by", "blocking"}
+	query := regexp.QuoteMeta("SELECT id, date, title, comment, repeat, anchor, exdates, occurrence, priority, status, project_id, parent_id, time, reminders, version, deleted_at, (SELECT group_concat(tags.name, ',') FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE task_tags.task_id = scheduler.id) AS tags, (SELECT group_concat(dependencies.blocker_id, ',') FROM dependencies JOIN scheduler AS blocker ON blocker.id = dependencies.blocker_id WHERE dependencies.task_id = scheduler.id AND blocker.deleted_at IS NULL) AS blocked_by, (SELECT group_concat(dependencies.task_id, ',') FROM dependencies JOIN scheduler AS blocked ON blocked.id = dependencies.task_id WHERE dependencies.blocker_id = scheduler.id AND blocked.deleted_at IS NULL) AS blocking FROM scheduler WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC, id DESC LIMIT ?")
+
+	tests := []struct {
+		name      string
+		mocks     func(dbMock sqlmock.Sqlmock)
+		wantTasks []models.Task
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "trashed tasks",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(2, "20240203", "Test title task 2", "", "", "due", "", 1, 3, "todo", 0, 0, "", "", 1, "2025-04-11T08:00:00Z", "home", nil, nil).
+					AddRow(1, "20240203", "Test title task 1", "", "d 7", "due", "", 1, 3, "todo", 0, 0, "", "", 1, "2025-04-10T10:30:00Z", nil, nil, nil)
+				dbMock.ExpectQuery(query).WithArgs(3).WillReturnRows(rows)
+			},
+			wantTasks: []models.Task{
+				{ID: 2, Date: "20240203", Title: "Test title task 2", Anchor: "due", Occurrence: 1, Priority: 3, Status: "todo", Version: 1, Tags: []string{"home"}, DeletedAt: "2025-04-11T08:00:00Z"},
+				{ID: 1, Date: "20240203", Title: "Test title task 1", Repeat: "d 7", Anchor: "due", Occurrence: 1, Priority: 3, Status: "todo", Version: 1, DeletedAt: "2025-04-10T10:30:00Z"},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs(3).WillReturnError(errors.New("database error"))
+			},
+			wantTasks: []models.Task{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot execute query: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			tasks, err := storage.ReadTrash(context.Background())
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantTasks, tasks)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_Restore(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("UPDATE scheduler SET deleted_at = NULL WHERE deleted_at IS NOT NULL AND (id = ? OR parent_id = ? AND deleted_at = (SELECT deleted_at FROM scheduler WHERE id = ?))")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "restored",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(1, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "restored with subtasks",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(1, 1, 1).WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "not in trash",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(1, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+				require.EqualError(tt, err, "task 1 not found in the trash", i...)
+			},
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs(1, 1, 1).WillReturnError(errors.New("database error"))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to restore task: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.Restore(context.Background(), 1)
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_Purge(t *testing.T) {
+	t.Parallel()
+
+	const before = "2025-03-11T10:30:00Z"
+	const purgeable = "deleted_at IS NOT NULL AND deleted_at < ? OR parent_id IN (SELECT id FROM scheduler WHERE deleted_at IS NOT NULL AND deleted_at < ?)"
+	tagsQuery := regexp.QuoteMeta("DELETE FROM task_tags WHERE task_id IN (SELECT id FROM scheduler WHERE " + purgeable + ")")
+	dependenciesQuery := regexp.QuoteMeta("DELETE FROM dependencies WHERE task_id IN (SELECT id FROM scheduler WHERE " + purgeable + ") OR blocker_id IN (SELECT id FROM scheduler WHERE " + purgeable + ")")
+	query := regexp.QuoteMeta("DELETE FROM scheduler WHERE " + purgeable)
+
+	tests := []struct {
+		name       string
+		mocks      func(dbMock sqlmock.Sqlmock)
+		wantPurged int64
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			name: "purged",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(tagsQuery).WithArgs(before, before).WillReturnResult(sqlmock.NewResult(0, 6))
+				dbMock.ExpectExec(dependenciesQuery).WithArgs(before, before, before, before).WillReturnResult(sqlmock.NewResult(0, 2))
+				dbMock.ExpectExec(query).WithArgs(before, before).WillReturnResult(sqlmock.NewResult(0, 4))
+				dbMock.ExpectCommit()
+			},
+			wantPurged: 4,
+			wantErr:    require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(tagsQuery).WithArgs(before, before).WillReturnResult(sqlmock.NewResult(0, 6))
+				dbMock.ExpectExec(dependenciesQuery).WithArgs(before, before, before, before).WillReturnResult(sqlmock.NewResult(0, 2))
+				dbMock.ExpectExec(query).WithArgs(before, before).WillReturnError(errors.New("database error"))
+				dbMock.ExpectRollback()
+			},
+			wantPurged: 0,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to purge trash: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			purged, err := storage.Purge(context.Background(), before)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantPurged, purged)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+)
+
+// userColumns lists the users columns in the order expected by scanUser.
+const userColumns = `id, name, password_hash, admin, timezone, created_at`
+
+func scanUser(row scanner) (models.User, error) {
+	var u models.User
+	err := row.Scan(&u.ID, &u.Name, &u.PasswordHash, &u.Admin, &u.Timezone, &u.CreatedAt)
+	return u, err
+}
+
+// CreateUser adds a new account. The name must not be taken by another account.
+//
+// Returns:
+// - int64: ID of the created account.
+// - error: Wrapped error if the insert fails.
+func (s TaskStorage) CreateUser(ctx context.Context, u models.User) (int64, error) {
+	query := `INSERT INTO users (name, password_hash, admin, timezone, created_at) VALUES (?, ?, ?, ?, ?)`
+	result, err := s.conn(ctx).ExecContext(ctx, query, u.Name, u.PasswordHash, u.Admin, u.Timezone, u.CreatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("cannot insert user in database: %w", err)
+	}
+
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("cannot take last insert id: %w", err)
+	}
+
+	return lastID, nil
+}
+
+// ReadUser retrieves an account by its ID.
+//
+// Returns:
+// - models.User: The account if found.
+// - error: An error of the domain.ErrNotFound kind if there is no such account, or a wrapped error if a database operation fails.
+func (s TaskStorage) ReadUser(ctx context.Context, id int64) (models.User, error) {
+	row := s.conn(ctx).QueryRowContext(ctx, `SELECT `+userColumns+` FROM users WHERE id = ?`, id)
+	return readUser(row, fmt.Sprintf("user %d not found", id))
+}
+
+// ReadUserByName retrieves an account by the name the user signs in with.
+//
+// Returns:
+// - models.User: The account if found.
+// - error: An error of the domain.ErrNotFound kind if there is no such account, or a wrapped error if a database operation fails.
+func (s TaskStorage) ReadUserByName(ctx context.Context, name string) (models.User, error) {
+	row := s.conn(ctx).QueryRowContext(ctx, `SELECT `+userColumns+` FROM users WHERE name = ?`, name)
+	return readUser(row, fmt.Sprintf("user %q not found", name))
+}
+
+func readUser(row *sql.Row, notFound string) (models.User, error) {
+	u, err := scanUser(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.User{}, domain.New(domain.ErrNotFound, notFound)
+	}
+	if err != nil {
+		return models.User{}, fmt.Errorf("cannot read user from database: %w", err)
+	}
+	return u, nil
+}
+
+// ReadUsers retrieves all accounts in alphabetical order.
+//
+// Returns:
+// - []models.User: A slice of accounts.
+// - error: Wrapped error if the query fails.
+func (s TaskStorage) ReadUsers(ctx context.Context) ([]models.User, error) {
+	rows, err := s.conn(ctx).QueryContext(ctx, `SELECT `+userColumns+` FROM users ORDER BY name`)
+	if err != nil {
+		return make([]models.User, 0), fmt.Errorf("cannot execute query: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]models.User, 0)
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return make([]models.User, 0), fmt.Errorf("cannot read row: %w", err)
+		}
+		users = append(users, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return make([]models.User, 0), fmt.Errorf("cannot read users: %w", err)
+	}
+
+	return users, nil
+}
+
+// UpdateUser changes the password hash, the admin rights and the time zone of an account.
+//
+// Returns:
+// - error: An error of the domain.ErrNotFound kind if there is no account with the ID, or a wrapped error if the update fails.
+func (s TaskStorage) UpdateUser(ctx context.Context, u models.User) error {
+	query := `UPDATE users SET password_hash = ?, admin = ?, timezone = ? WHERE id = ?`
+	result, err := s.conn(ctx).ExecContext(ctx, query, u.PasswordHash, u.Admin, u.Timezone, u.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return expectAffected(result, domain.New(domain.ErrNotFound, fmt.Sprintf("user %d not found", u.ID)))
+}
+
+// DeleteUser removes an account together with everything it owns: its tasks, including those in the trash,
+// with their tags, dependencies and sent reminders, its tags, projects, completions and undo history.
+// The audit log keeps the changes the user made.
+//
+// Returns:
+// - error: An error of the domain.ErrNotFound kind if there is no account with the ID, or a wrapped error if any statement fails.
+// Nothing is changed in either case.
+func (s TaskStorage) DeleteUser(ctx context.Context, id int64) error {
+	return s.InTx(ctx, func(ctx context.Context) error {
+		const tasks = `SELECT id FROM scheduler WHERE owner_id = ?`
+		for _, stmt := range []struct {
+			query string
+			args  []any
+		}{
+			{`DELETE FROM task_tags WHERE task_id IN (` + tasks + `)`, []any{id}},
+			{`DELETE FROM dependencies WHERE task_id IN (` + tasks + `) OR blocker_id IN (` + tasks + `)`, []any{id, id}},
+			{`DELETE FROM reminders WHERE task_id IN (` + tasks + `)`, []any{id}},
+			{`DELETE FROM scheduler WHERE owner_id = ?`, []any{id}},
+			{`DELETE FROM completions WHERE owner_id = ?`, []any{id}},
+			{`DELETE FROM operations WHERE owner_id = ?`, []any{id}},
+			{`DELETE FROM tags WHERE owner_id = ?`, []any{id}},
+			{`DELETE FROM projects WHERE owner_id = ?`, []any{id}},
+		} {
+			if _, err := s.conn(ctx).ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+				return fmt.Errorf("failed to delete user: %w", err)
+			}
+		}
+
+		result, err := s.conn(ctx).ExecContext(ctx, `DELETE FROM users WHERE id = ?`, id)
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		return expectAffected(result, domain.New(domain.ErrNotFound, fmt.Sprintf("user %d not found", id)))
+	})
+}
+
+// AdoptOrphans gives the tasks, tags, projects, completions and operations which belong to nobody,
+// because they were made before there were accounts, to the user with the ID.
+//
+// Returns:
+// - error: Wrapped error if any statement fails. Nothing is changed in that case.
+func (s TaskStorage) AdoptOrphans(ctx context.Context, userID int64) error {
+	return s.InTx(ctx, func(ctx context.Context) error {
+		for _, table := range []string{"scheduler", "tags", "projects", "completions", "operations"} {
+			query := `UPDATE ` + table + ` SET owner_id = ? WHERE owner_id = 0`
+			if _, err := s.conn(ctx).ExecContext(ctx, query, userID); err != nil {
+				return fmt.Errorf("failed to adopt orphaned records: %w", err)
+			}
+		}
+		return nil
+	})
+}
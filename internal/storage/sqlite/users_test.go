@@ -0,0 +1,280 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/services/domain"
+	"github.com/10Narratives/task-tracker/internal/storage/sqlite"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var userColumns = []string{"id", "name", "password_hash", "admin", "timezone", "created_at"}
+
+func TestTaskStorage_CreateUser(t *testing.T) {
+	t.Parallel()
+
+	user := models.User{Name: "alice", PasswordHash: "$2a$10$hash", Admin: true, Timezone: "Europe/Berlin", CreatedAt: "2025-04-10T10:30:00Z"}
+	query := regexp.QuoteMeta("INSERT INTO users (name, password_hash, admin, timezone, created_at) VALUES (?, ?, ?, ?, ?)")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantID  int64
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "successful creation",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("alice", "$2a$10$hash", true, "Europe/Berlin", "2025-04-10T10:30:00Z").WillReturnResult(sqlmock.NewResult(3, 1))
+			},
+			wantID:  3,
+			wantErr: require.NoError,
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("alice", "$2a$10$hash", true, "Europe/Berlin", "2025-04-10T10:30:00Z").WillReturnError(errors.New("database error"))
+			},
+			wantID: 0,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot insert user in database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			id, err := storage.CreateUser(context.Background(), user)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantID, id)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_ReadUserByName(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("SELECT id, name, password_hash, admin, timezone, created_at FROM users WHERE name = ?")
+
+	tests := []struct {
+		name     string
+		mocks    func(dbMock sqlmock.Sqlmock)
+		wantUser models.User
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "user found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs("alice").
+					WillReturnRows(sqlmock.NewRows(userColumns).AddRow(3, "alice", "$2a$10$hash", false, "Europe/Berlin", "2025-04-10T10:30:00Z"))
+			},
+			wantUser: models.User{ID: 3, Name: "alice", PasswordHash: "$2a$10$hash", Timezone: "Europe/Berlin", CreatedAt: "2025-04-10T10:30:00Z"},
+			wantErr:  require.NoError,
+		},
+		{
+			name: "user not found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs("alice").WillReturnRows(sqlmock.NewRows(userColumns))
+			},
+			wantUser: models.User{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+				require.EqualError(tt, err, `user "alice" not found`, i...)
+			},
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectQuery(query).WithArgs("alice").WillReturnError(errors.New("database error"))
+			},
+			wantUser: models.User{},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "cannot read user from database: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			user, err := storage.ReadUserByName(context.Background(), "alice")
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantUser, user)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_UpdateUser(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta("UPDATE users SET password_hash = ?, admin = ?, timezone = ? WHERE id = ?")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "user updated",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("$2a$10$hash", true, "Europe/Berlin", 3).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "user not found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectExec(query).WithArgs("$2a$10$hash", true, "Europe/Berlin", 3).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+				require.EqualError(tt, err, "user 3 not found", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.UpdateUser(context.Background(), models.User{ID: 3, PasswordHash: "$2a$10$hash", Admin: true, Timezone: "Europe/Berlin"})
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_DeleteUser(t *testing.T) {
+	t.Parallel()
+
+	owned := []struct {
+		query string
+		args  []driver.Value
+	}{
+		{"DELETE FROM task_tags WHERE task_id IN (SELECT id FROM scheduler WHERE owner_id = ?)", []driver.Value{3}},
+		{"DELETE FROM dependencies WHERE task_id IN (SELECT id FROM scheduler WHERE owner_id = ?) OR blocker_id IN (SELECT id FROM scheduler WHERE owner_id = ?)", []driver.Value{3, 3}},
+		{"DELETE FROM reminders WHERE task_id IN (SELECT id FROM scheduler WHERE owner_id = ?)", []driver.Value{3}},
+		{"DELETE FROM scheduler WHERE owner_id = ?", []driver.Value{3}},
+		{"DELETE FROM completions WHERE owner_id = ?", []driver.Value{3}},
+		{"DELETE FROM operations WHERE owner_id = ?", []driver.Value{3}},
+		{"DELETE FROM tags WHERE owner_id = ?", []driver.Value{3}},
+		{"DELETE FROM projects WHERE owner_id = ?", []driver.Value{3}},
+	}
+	expectOwned := func(dbMock sqlmock.Sqlmock) {
+		for _, stmt := range owned {
+			dbMock.ExpectExec(regexp.QuoteMeta(stmt.query)).WithArgs(stmt.args...).WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+	}
+	deleteQuery := regexp.QuoteMeta("DELETE FROM users WHERE id = ?")
+
+	tests := []struct {
+		name    string
+		mocks   func(dbMock sqlmock.Sqlmock)
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "user and records deleted",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				expectOwned(dbMock)
+				dbMock.ExpectExec(deleteQuery).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+				dbMock.ExpectCommit()
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "user not found",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				expectOwned(dbMock)
+				dbMock.ExpectExec(deleteQuery).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 0))
+				dbMock.ExpectRollback()
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(tt, err, domain.ErrNotFound, i...)
+				require.EqualError(tt, err, "user 3 not found", i...)
+			},
+		},
+		{
+			name: "database error",
+			mocks: func(dbMock sqlmock.Sqlmock) {
+				dbMock.ExpectBegin()
+				dbMock.ExpectExec(regexp.QuoteMeta(owned[0].query)).WithArgs(3).WillReturnError(errors.New("database error"))
+				dbMock.ExpectRollback()
+			},
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "failed to delete user: database error", i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, dbMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			storage := sqlite.New(db, 3)
+			tt.mocks(dbMock)
+
+			err = storage.DeleteUser(context.Background(), 3)
+			tt.wantErr(t, err)
+
+			require.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskStorage_AdoptOrphans(t *testing.T) {
+	t.Parallel()
+
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dbMock.ExpectBegin()
+	for _, table := range []string{"scheduler", "tags", "projects", "completions", "operations"} {
+		dbMock.ExpectExec(regexp.QuoteMeta("UPDATE " + table + " SET owner_id = ? WHERE owner_id = 0")).
+			WithArgs(3).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+	}
+	dbMock.ExpectCommit()
+
+	storage := sqlite.New(db, 3)
+	require.NoError(t, storage.AdoptOrphans(context.Background(), 3))
+
+	require.NoError(t, dbMock.ExpectationsWereMet())
+}
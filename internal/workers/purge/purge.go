@@ -0,0 +1,63 @@
+// Package purge empties the trash of tasks kept there for longer than the retention period.
+package purge
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const op = "workers.Purge"
+
+// Purger permanently removes the tasks which have been in the trash for longer than retention.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=Purger
+type Purger interface {
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// Worker purges the trash on a fixed interval.
+type Worker struct {
+	logger    *slog.Logger
+	purger    Purger
+	retention time.Duration
+	interval  time.Duration
+}
+
+// New creates a worker which purges tasks trashed more than retention ago every interval.
+func New(logger *slog.Logger, purger Purger, retention, interval time.Duration) Worker {
+	return Worker{
+		logger:    logger.With(slog.String("op", op)),
+		purger:    purger,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Run purges the trash right away and then on every tick until ctx is cancelled.
+func (w Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	w.Purge(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.Purge(ctx)
+		}
+	}
+}
+
+// Purge runs a single purge and logs its outcome.
+func (w Worker) Purge(ctx context.Context) {
+	purged, err := w.purger.Purge(ctx, w.retention)
+	if err != nil {
+		w.logger.Error("failed to purge trash", slog.String("error", err.Error()))
+		return
+	}
+	if purged > 0 {
+		w.logger.Info("trash purged", slog.Int64("tasks", purged))
+	}
+}
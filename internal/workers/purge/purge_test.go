@@ -0,0 +1,63 @@
+package purge_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/workers/purge"
+	"github.com/10Narratives/task-tracker/internal/workers/purge/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWorker_Purge(t *testing.T) {
+	tests := []struct {
+		name   string
+		purged int64
+		err    error
+	}{
+		{name: "purged", purged: 3},
+		{name: "nothing to purge", purged: 0},
+		{name: "storage error", err: errors.New("database error")},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			purger := mocks.NewPurger(t)
+			purger.On("Purge", mock.Anything, 720*time.Hour).Return(tc.purged, tc.err).Once()
+
+			worker := purge.New(slogdiscard.NewDiscardLogger(), purger, 720*time.Hour, time.Hour)
+			worker.Purge(context.Background())
+		})
+	}
+}
+
+func TestWorker_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	purger := mocks.NewPurger(t)
+	purger.On("Purge", mock.Anything, time.Hour).Return(int64(0), nil).Once()
+	purger.On("Purge", mock.Anything, time.Hour).Return(int64(1), nil).Once().Run(func(mock.Arguments) { cancel() })
+	// A tick may still win the race against the cancelled context.
+	purger.On("Purge", mock.Anything, time.Hour).Return(int64(0), nil).Maybe()
+
+	worker := purge.New(slogdiscard.NewDiscardLogger(), purger, time.Hour, time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop after the context was cancelled")
+	}
+}
@@ -0,0 +1,68 @@
+package remind
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+// LogNotifier writes reminders to a log.
+type LogNotifier struct {
+	logger *slog.Logger
+}
+
+// NewLogNotifier creates a notifier which writes reminders to the logger.
+func NewLogNotifier(logger *slog.Logger) LogNotifier {
+	return LogNotifier{logger: logger}
+}
+
+// Notify logs the reminder.
+func (n LogNotifier) Notify(_ context.Context, r models.Reminder) error {
+	n.logger.Info("task reminder",
+		slog.Int64("task_id", r.TaskID),
+		slog.String("title", r.Title),
+		slog.String("due_at", r.DueAt),
+	)
+	return nil
+}
+
+// WebhookNotifier posts reminders as JSON to a URL.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a notifier which posts reminders to the URL with the client.
+func NewWebhookNotifier(url string, client *http.Client) WebhookNotifier {
+	return WebhookNotifier{url: url, client: client}
+}
+
+// Notify posts the reminder. Any response status other than 2xx is an error.
+func (n WebhookNotifier) Notify(ctx context.Context, r models.Reminder) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("cannot encode reminder: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("cannot create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
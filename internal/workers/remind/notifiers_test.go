@@ -0,0 +1,56 @@
+package remind_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/workers/remind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	reminder := models.Reminder{TaskID: 3, Title: "Dentist", Offset: 15, DueAt: "2024-02-03T14:30:00Z", RemindAt: "2024-02-03T14:15:00Z"}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr require.ErrorAssertionFunc
+	}{
+		{name: "reminder delivered", status: http.StatusNoContent, wantErr: require.NoError},
+		{
+			name:   "webhook fails",
+			status: http.StatusBadGateway,
+			wantErr: func(tt require.TestingT, err error, i ...interface{}) {
+				require.EqualError(tt, err, "webhook responded with status 502", i...)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				var got models.Reminder
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+				assert.Equal(t, reminder, got)
+
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			notifier := remind.NewWebhookNotifier(server.URL, server.Client())
+			tc.wantErr(t, notifier.Notify(context.Background(), reminder))
+		})
+	}
+}
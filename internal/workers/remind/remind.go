@@ -0,0 +1,100 @@
+// Package remind delivers the reminders of tasks which are due soon.
+package remind
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/models"
+)
+
+const op = "workers.Remind"
+
+// Reminders finds the reminders which are due and records the delivered ones.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=Reminders
+type Reminders interface {
+	PendingReminders(ctx context.Context) ([]models.Reminder, time.Time, error)
+	MarkReminderSent(ctx context.Context, r models.Reminder) error
+}
+
+// Notifier delivers a reminder somewhere, for example to a log or a webhook.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=Notifier
+type Notifier interface {
+	Notify(ctx context.Context, r models.Reminder) error
+}
+
+// Worker sends reminders to its notifiers when they are due.
+type Worker struct {
+	logger    *slog.Logger
+	reminders Reminders
+	notifiers []Notifier
+	interval  time.Duration
+}
+
+// New creates a worker which sends reminders to the notifiers.
+// It looks for new or changed reminders at least every interval.
+func New(logger *slog.Logger, reminders Reminders, interval time.Duration, notifiers ...Notifier) Worker {
+	return Worker{
+		logger:    logger.With(slog.String("op", op)),
+		reminders: reminders,
+		notifiers: notifiers,
+		interval:  interval,
+	}
+}
+
+// Run sends the due reminders right away and then sleeps until the next reminder is due,
+// but never longer than the interval, until ctx is cancelled.
+func (w Worker) Run(ctx context.Context) {
+	timer := time.NewTimer(w.wait(w.Remind(ctx)))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			timer.Reset(w.wait(w.Remind(ctx)))
+		}
+	}
+}
+
+// wait returns how long to sleep before the reminder due at next.
+func (w Worker) wait(next time.Time) time.Duration {
+	if next.IsZero() {
+		return w.interval
+	}
+	return min(max(time.Until(next), 0), w.interval)
+}
+
+// Remind sends the due reminders to every notifier and returns the time of the next reminder.
+// A reminder is recorded as sent only once every notifier has delivered it, so that a notifier which always
+// succeeds, such as the log, cannot hide a failed webhook. If any notifier fails, the reminder is sent
+// to all of them again on the next run.
+func (w Worker) Remind(ctx context.Context) time.Time {
+	reminders, next, err := w.reminders.PendingReminders(ctx)
+	if err != nil {
+		w.logger.Error("failed to read reminders", slog.String("error", err.Error()))
+		return time.Time{}
+	}
+
+	for _, r := range reminders {
+		delivered := true
+		for _, notifier := range w.notifiers {
+			if err := notifier.Notify(ctx, r); err != nil {
+				w.logger.Error("failed to send reminder", slog.Int64("task_id", r.TaskID), slog.String("error", err.Error()))
+				delivered = false
+			}
+		}
+		if !delivered {
+			continue
+		}
+
+		if err := w.reminders.MarkReminderSent(ctx, r); err != nil {
+			w.logger.Error("failed to record reminder", slog.Int64("task_id", r.TaskID), slog.String("error", err.Error()))
+		}
+	}
+	return next
+}
@@ -0,0 +1,106 @@
+package remind_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/models"
+	"github.com/10Narratives/task-tracker/internal/workers/remind"
+	"github.com/10Narratives/task-tracker/internal/workers/remind/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWorker_Remind(t *testing.T) {
+	var (
+		reminder = models.Reminder{TaskID: 3, Title: "Dentist", Offset: 15, DueAt: "2024-02-03T14:30:00Z", RemindAt: "2024-02-03T14:15:00Z"}
+		next     = time.Date(2024, 2, 3, 14, 25, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name      string
+		mockSetup func(reminders *mocks.Reminders, first, second *mocks.Notifier)
+		wantNext  time.Time
+	}{
+		{
+			name: "reminder is sent and recorded",
+			mockSetup: func(reminders *mocks.Reminders, first, second *mocks.Notifier) {
+				reminders.On("PendingReminders", mock.Anything).Return([]models.Reminder{reminder}, next, nil).Once()
+				first.On("Notify", mock.Anything, reminder).Return(nil).Once()
+				second.On("Notify", mock.Anything, reminder).Return(nil).Once()
+				reminders.On("MarkReminderSent", mock.Anything, reminder).Return(nil).Once()
+			},
+			wantNext: next,
+		},
+		{
+			name: "reminder delivered by one notifier only is kept for the next run",
+			mockSetup: func(reminders *mocks.Reminders, first, second *mocks.Notifier) {
+				reminders.On("PendingReminders", mock.Anything).Return([]models.Reminder{reminder}, next, nil).Once()
+				first.On("Notify", mock.Anything, reminder).Return(nil).Once()
+				second.On("Notify", mock.Anything, reminder).Return(errors.New("webhook is down")).Once()
+			},
+			wantNext: next,
+		},
+		{
+			name: "undelivered reminder is kept for the next run",
+			mockSetup: func(reminders *mocks.Reminders, first, second *mocks.Notifier) {
+				reminders.On("PendingReminders", mock.Anything).Return([]models.Reminder{reminder}, next, nil).Once()
+				first.On("Notify", mock.Anything, reminder).Return(errors.New("webhook is down")).Once()
+				second.On("Notify", mock.Anything, reminder).Return(errors.New("webhook is down")).Once()
+			},
+			wantNext: next,
+		},
+		{
+			name: "storage error",
+			mockSetup: func(reminders *mocks.Reminders, _, _ *mocks.Notifier) {
+				reminders.On("PendingReminders", mock.Anything).Return(nil, time.Time{}, errors.New("database error")).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reminders := mocks.NewReminders(t)
+			first, second := mocks.NewNotifier(t), mocks.NewNotifier(t)
+			tc.mockSetup(reminders, first, second)
+
+			worker := remind.New(slogdiscard.NewDiscardLogger(), reminders, time.Minute, first, second)
+			assert.Equal(t, tc.wantNext, worker.Remind(context.Background()))
+		})
+	}
+}
+
+func TestWorker_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	reminder := models.Reminder{TaskID: 3, RemindAt: "2024-02-03T14:15:00Z"}
+
+	reminders := mocks.NewReminders(t)
+	notifier := mocks.NewNotifier(t)
+	// The next reminder is due in a moment, so the worker wakes up before the interval is over.
+	reminders.On("PendingReminders", mock.Anything).Return(nil, time.Now().Add(time.Millisecond), nil).Once()
+	reminders.On("PendingReminders", mock.Anything).Return([]models.Reminder{reminder}, time.Time{}, nil).Once()
+	notifier.On("Notify", mock.Anything, reminder).Return(nil).Once()
+	reminders.On("MarkReminderSent", mock.Anything, reminder).Return(nil).Once().Run(func(mock.Arguments) { cancel() })
+
+	worker := remind.New(slogdiscard.NewDiscardLogger(), reminders, time.Hour, notifier)
+
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop after the context was cancelled")
+	}
+}
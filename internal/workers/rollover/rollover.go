@@ -0,0 +1,61 @@
+// Package rollover moves overdue recurring tasks to their next occurrence.
+package rollover
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const op = "workers.Rollover"
+
+// Roller moves the overdue recurring tasks to their next occurrence, recording the missed ones as skipped.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.52.1 --name=Roller
+type Roller interface {
+	Rollover(ctx context.Context) (int64, error)
+}
+
+// Worker rolls overdue tasks over on a fixed interval.
+type Worker struct {
+	logger   *slog.Logger
+	roller   Roller
+	interval time.Duration
+}
+
+// New creates a worker which rolls overdue tasks over every interval.
+func New(logger *slog.Logger, roller Roller, interval time.Duration) Worker {
+	return Worker{
+		logger:   logger.With(slog.String("op", op)),
+		roller:   roller,
+		interval: interval,
+	}
+}
+
+// Run rolls overdue tasks over right away and then on every tick until ctx is cancelled.
+func (w Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	w.Rollover(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.Rollover(ctx)
+		}
+	}
+}
+
+// Rollover runs a single rollover and logs its outcome.
+// Tasks which could be moved are logged even if others failed.
+func (w Worker) Rollover(ctx context.Context) {
+	moved, err := w.roller.Rollover(ctx)
+	if err != nil {
+		w.logger.Error("failed to roll over overdue tasks", slog.String("error", err.Error()))
+	}
+	if moved > 0 {
+		w.logger.Info("overdue tasks rolled over", slog.Int64("tasks", moved))
+	}
+}
@@ -0,0 +1,64 @@
+package rollover_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10Narratives/task-tracker/internal/lib/logging/handlers/slogdiscard"
+	"github.com/10Narratives/task-tracker/internal/workers/rollover"
+	"github.com/10Narratives/task-tracker/internal/workers/rollover/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWorker_Rollover(t *testing.T) {
+	tests := []struct {
+		name  string
+		moved int64
+		err   error
+	}{
+		{name: "rolled over", moved: 3},
+		{name: "nothing overdue", moved: 0},
+		{name: "some tasks failed", moved: 2, err: errors.New("task 4: database error")},
+		{name: "storage error", err: errors.New("database error")},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			roller := mocks.NewRoller(t)
+			roller.On("Rollover", mock.Anything).Return(tc.moved, tc.err).Once()
+
+			worker := rollover.New(slogdiscard.NewDiscardLogger(), roller, time.Hour)
+			worker.Rollover(context.Background())
+		})
+	}
+}
+
+func TestWorker_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	roller := mocks.NewRoller(t)
+	roller.On("Rollover", mock.Anything).Return(int64(0), nil).Once()
+	roller.On("Rollover", mock.Anything).Return(int64(1), nil).Once().Run(func(mock.Arguments) { cancel() })
+	// A tick may still win the race against the cancelled context.
+	roller.On("Rollover", mock.Anything).Return(int64(0), nil).Maybe()
+
+	worker := rollover.New(slogdiscard.NewDiscardLogger(), roller, time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop after the context was cancelled")
+	}
+}
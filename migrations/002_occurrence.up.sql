@@ -0,0 +1 @@
+ALTER TABLE scheduler ADD COLUMN occurrence INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1 @@
+ALTER TABLE scheduler ADD COLUMN exdates TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS completions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    date TEXT NOT NULL,
+    completed_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_completions_task_id ON completions(task_id);
+CREATE INDEX IF NOT EXISTS idx_completions_completed_at ON completions(completed_at);
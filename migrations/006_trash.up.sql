@@ -0,0 +1,2 @@
+ALTER TABLE scheduler ADD COLUMN deleted_at TEXT;
+CREATE INDEX IF NOT EXISTS idx_scheduler_deleted_at ON scheduler(deleted_at);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS operations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    kind TEXT NOT NULL,
+    task_id INTEGER NOT NULL,
+    task TEXT NOT NULL DEFAULT '',
+    completion_id INTEGER NOT NULL DEFAULT 0,
+    created_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_operations_created_at ON operations(created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE scheduler ADD COLUMN priority INTEGER NOT NULL DEFAULT 3;
+ALTER TABLE scheduler ADD COLUMN status TEXT NOT NULL DEFAULT 'todo';
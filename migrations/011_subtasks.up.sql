@@ -0,0 +1,2 @@
+ALTER TABLE scheduler ADD COLUMN parent_id INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_scheduler_parent_id ON scheduler(parent_id);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS dependencies (
+    task_id INTEGER NOT NULL,
+    blocker_id INTEGER NOT NULL,
+    PRIMARY KEY (task_id, blocker_id)
+);
+CREATE INDEX IF NOT EXISTS idx_dependencies_blocker_id ON dependencies(blocker_id);
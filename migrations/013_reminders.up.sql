@@ -0,0 +1,9 @@
+ALTER TABLE scheduler ADD COLUMN time TEXT NOT NULL DEFAULT '';
+ALTER TABLE scheduler ADD COLUMN reminders TEXT NOT NULL DEFAULT '';
+CREATE TABLE IF NOT EXISTS reminders (
+    task_id INTEGER NOT NULL,
+    remind_at TEXT NOT NULL,
+    sent_at TEXT NOT NULL,
+    PRIMARY KEY (task_id, remind_at)
+);
+CREATE INDEX IF NOT EXISTS idx_reminders_remind_at ON reminders(remind_at);
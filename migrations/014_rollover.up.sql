@@ -0,0 +1 @@
+ALTER TABLE completions ADD COLUMN skipped INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE scheduler ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
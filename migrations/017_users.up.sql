@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    password_hash TEXT NOT NULL,
+    admin INTEGER NOT NULL DEFAULT 0,
+    created_at TEXT NOT NULL
+);
+ALTER TABLE scheduler ADD COLUMN owner_id INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_scheduler_owner_id ON scheduler(owner_id);
+ALTER TABLE completions ADD COLUMN owner_id INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE operations ADD COLUMN owner_id INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE projects ADD COLUMN owner_id INTEGER NOT NULL DEFAULT 0;
+CREATE TABLE tags_owned (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    owner_id INTEGER NOT NULL DEFAULT 0,
+    name TEXT NOT NULL,
+    UNIQUE (owner_id, name)
+);
+INSERT INTO tags_owned (id, name) SELECT id, name FROM tags;
+DROP TABLE tags;
+ALTER TABLE tags_owned RENAME TO tags;
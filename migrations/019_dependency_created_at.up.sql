@@ -0,0 +1 @@
+ALTER TABLE dependencies ADD COLUMN created_at TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,9 @@
+// Package migrations holds the SQL migrations of the database schema, applied in the order of their numbers.
+package migrations
+
+import "embed"
+
+// FS holds the up migrations, so that the tracker can bring its database up to date on start.
+//
+//go:embed *.sql
+var FS embed.FS